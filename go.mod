@@ -7,7 +7,7 @@ require (
 	github.com/YakDriver/go-version v0.1.0
 	github.com/YakDriver/regexache v0.24.0
 	github.com/aws/aws-sdk-go v1.55.5
-	github.com/aws/aws-sdk-go-v2 v1.30.5
+	github.com/aws/aws-sdk-go-v2 v1.32.5
 	github.com/aws/aws-sdk-go-v2/config v1.27.35
 	github.com/aws/aws-sdk-go-v2/credentials v1.17.33
 	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.16.13
@@ -26,7 +26,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/appintegrations v1.28.4
 	github.com/aws/aws-sdk-go-v2/service/applicationautoscaling v1.31.5
 	github.com/aws/aws-sdk-go-v2/service/applicationinsights v1.26.7
-	github.com/aws/aws-sdk-go-v2/service/applicationsignals v1.4.1
+	github.com/aws/aws-sdk-go-v2/service/applicationsignals v1.7.0
 	github.com/aws/aws-sdk-go-v2/service/appmesh v1.27.8
 	github.com/aws/aws-sdk-go-v2/service/apprunner v1.30.7
 	github.com/aws/aws-sdk-go-v2/service/appstream v1.38.4
@@ -55,7 +55,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/cloudsearch v1.24.7
 	github.com/aws/aws-sdk-go-v2/service/cloudtrail v1.42.8
 	github.com/aws/aws-sdk-go-v2/service/cloudwatch v1.40.8
-	github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs v1.39.1
+	github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs v1.44.0
 	github.com/aws/aws-sdk-go-v2/service/codeartifact v1.30.7
 	github.com/aws/aws-sdk-go-v2/service/codebuild v1.43.0
 	github.com/aws/aws-sdk-go-v2/service/codecatalyst v1.15.7
@@ -95,7 +95,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/docdbelastic v1.11.7
 	github.com/aws/aws-sdk-go-v2/service/drs v1.28.7
 	github.com/aws/aws-sdk-go-v2/service/dynamodb v1.34.10
-	github.com/aws/aws-sdk-go-v2/service/ec2 v1.177.4
+	github.com/aws/aws-sdk-go-v2/service/ec2 v1.187.0
 	github.com/aws/aws-sdk-go-v2/service/ecr v1.34.0
 	github.com/aws/aws-sdk-go-v2/service/ecrpublic v1.25.7
 	github.com/aws/aws-sdk-go-v2/service/ecs v1.45.5
@@ -252,7 +252,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/workspaces v1.46.4
 	github.com/aws/aws-sdk-go-v2/service/workspacesweb v1.21.8
 	github.com/aws/aws-sdk-go-v2/service/xray v1.27.8
-	github.com/aws/smithy-go v1.20.4
+	github.com/aws/smithy-go v1.22.1
 	github.com/beevik/etree v1.4.1
 	github.com/cedar-policy/cedar-go v0.1.0
 	github.com/davecgh/go-spew v1.1.1
@@ -290,6 +290,7 @@ require (
 	github.com/mitchellh/mapstructure v1.5.0
 	github.com/pquerna/otp v1.4.0
 	github.com/shopspring/decimal v1.4.0
+	github.com/xeipuuv/gojsonschema v1.2.0
 	golang.org/x/crypto v0.27.0
 	golang.org/x/mod v0.21.0
 	golang.org/x/text v0.18.0
@@ -306,15 +307,15 @@ require (
 	github.com/agext/levenshtein v1.2.3 // indirect
 	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
 	github.com/armon/go-radix v1.0.0 // indirect
-	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.4 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.17 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.17 // indirect
+	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.7 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.24 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.24 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/ini v1.8.1 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/v4a v1.3.17 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.11.4 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.12.0 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/checksum v1.3.19 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/endpoint-discovery v1.9.18 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.11.19 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.12.3 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/s3shared v1.17.17 // indirect
 	github.com/aws/aws-sdk-go-v2/service/ssooidc v1.26.8 // indirect
 	github.com/bgentry/speakeasy v0.2.0 // indirect
@@ -353,7 +354,6 @@ require (
 	github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
 	github.com/xeipuuv/gojsonpointer v0.0.0-20190905194746-02993c407bfb // indirect
 	github.com/xeipuuv/gojsonreference v0.0.0-20180127040603-bd5ef7bd5415 // indirect
-	github.com/xeipuuv/gojsonschema v1.2.0 // indirect
 	github.com/zclconf/go-cty v1.15.0 // indirect
 	go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws v0.54.0 // indirect
 	go.opentelemetry.io/otel v1.30.0 // indirect
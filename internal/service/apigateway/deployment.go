@@ -120,6 +120,21 @@ func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta
 		Variables:        flex.ExpandStringValueMap(d.Get("variables").(map[string]interface{})),
 	}
 
+	// A canary release deployment can only be made against an existing stage.
+	// For a new stage the settings are ignored, as they always were before they were sent with the request.
+	if v, ok := d.GetOk("canary_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if stageName := d.Get("stage_name").(string); stageName != "" {
+			_, err := findStageByTwoPartKey(ctx, conn, d.Get("rest_api_id").(string), stageName)
+
+			switch {
+			case err == nil:
+				input.CanarySettings = expandDeploymentCanarySettings(v.([]interface{})[0].(map[string]interface{}))
+			case !tfresource.NotFound(err):
+				return sdkdiag.AppendErrorf(diags, "reading API Gateway Stage (%s): %s", stageName, err)
+			}
+		}
+	}
+
 	deployment, err := conn.CreateDeployment(ctx, input)
 
 	if err != nil {
@@ -127,9 +142,6 @@ func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	d.SetId(aws.ToString(deployment.Id))
-	if v, ok := d.GetOk("canary_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		input.CanarySettings = expandDeploymentCanarySettings(v.([]interface{})[0].(map[string]interface{}))
-	}
 
 	return append(diags, resourceDeploymentRead(ctx, d, meta)...)
 }
@@ -288,6 +300,237 @@ func findDeploymentByTwoPartKey(ctx context.Context, conn *apigateway.Client, re
 	return output, nil
 }
 
+// syncRestAPIDeployment creates a deployment of the REST API when required and points each of the named stages at it.
+// Switching the stages is not atomic: if a stage can't be created or updated, any stages already switched keep serving the new deployment.
+// The previous deployment configuration is then kept so that the next apply creates a new deployment and retries every stage.
+func syncRestAPIDeployment(ctx context.Context, conn *apigateway.Client, d *schema.ResourceData) error {
+	apiID := d.Id()
+	tfMap := d.Get("deployment").([]interface{})[0].(map[string]interface{})
+
+	o, _ := d.GetChange("deployment_ids")
+	oldDeploymentIDs := flex.ExpandStringValueList(o.([]interface{}))
+	deploymentIDs := oldDeploymentIDs
+	deploymentID := d.Get("deployment_id").(string)
+	created := false
+
+	if deploymentID == "" {
+		input := &apigateway.CreateDeploymentInput{
+			RestApiId: aws.String(apiID),
+		}
+
+		if v, ok := tfMap[names.AttrDescription].(string); ok && v != "" {
+			input.Description = aws.String(v)
+		}
+
+		output, err := conn.CreateDeployment(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("creating deployment: %w", err)
+		}
+
+		deploymentID = aws.ToString(output.Id)
+		deploymentIDs = append([]string{deploymentID}, deploymentIDs...)
+		created = true
+	}
+
+	o, n := d.GetChange("deployment.0.stage_names")
+	oldStageNames, newStageNames := o.(*schema.Set), n.(*schema.Set)
+
+	variables := tfMap["variables"].(map[string]interface{})
+	var canarySettings map[string]interface{}
+	if v, ok := tfMap["canary_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		canarySettings = v[0].(map[string]interface{})
+	}
+
+	switched := false
+	for _, stageName := range flex.ExpandStringValueSet(newStageNames) {
+		err := syncRestAPIDeploymentStage(ctx, conn, apiID, stageName, deploymentID, variables, canarySettings)
+
+		if err != nil {
+			// Restore the previous stage configuration so that the stages are synced again, and removed stages deleted, on the next apply.
+			o, _ := d.GetChange("deployment")
+			d.Set("deployment", o)
+
+			d.Set("deployment_ids", oldDeploymentIDs)
+
+			if created {
+				// A new deployment that serves stages is tracked, so that it is removed according to retention_count.
+				// One that doesn't serve any stage yet is removed rather than leaked.
+				if switched {
+					d.Set("deployment_ids", deploymentIDs)
+				} else if _, err := conn.DeleteDeployment(ctx, &apigateway.DeleteDeploymentInput{
+					DeploymentId: aws.String(deploymentID),
+					RestApiId:    aws.String(apiID),
+				}); err != nil {
+					log.Printf("[WARN] Deleting API Gateway REST API (%s) deployment (%s): %s", apiID, deploymentID, err)
+				}
+
+				// An empty deployment ID makes the next apply create a new deployment.
+				d.Set("deployment_id", "")
+			}
+
+			return err
+		}
+
+		switched = true
+	}
+
+	d.Set("deployment_id", deploymentID)
+	d.Set("deployment_ids", deploymentIDs)
+
+	// Stages are only deleted once every remaining stage serves the new deployment.
+	for _, stageName := range flex.ExpandStringValueSet(oldStageNames.Difference(newStageNames)) {
+		_, err := conn.DeleteStage(ctx, &apigateway.DeleteStageInput{
+			RestApiId: aws.String(apiID),
+			StageName: aws.String(stageName),
+		})
+
+		if errs.IsA[*types.NotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting stage (%s): %w", stageName, err)
+		}
+	}
+
+	if retentionCount := tfMap["retention_count"].(int); len(deploymentIDs) > retentionCount {
+		stages, err := findStages(ctx, conn, &apigateway.GetStagesInput{
+			RestApiId: aws.String(apiID),
+		})
+
+		if err != nil {
+			return fmt.Errorf("reading stages: %w", err)
+		}
+
+		inUse := make(map[string]bool)
+		for _, stage := range stages {
+			inUse[aws.ToString(stage.DeploymentId)] = true
+			if stage.CanarySettings != nil {
+				inUse[aws.ToString(stage.CanarySettings.DeploymentId)] = true
+			}
+		}
+
+		retained := deploymentIDs[:retentionCount:retentionCount]
+		for _, id := range deploymentIDs[retentionCount:] {
+			// Deployments still served by a stage (e.g. a canary's baseline) are kept until they are released.
+			if inUse[id] {
+				retained = append(retained, id)
+				continue
+			}
+
+			_, err := conn.DeleteDeployment(ctx, &apigateway.DeleteDeploymentInput{
+				DeploymentId: aws.String(id),
+				RestApiId:    aws.String(apiID),
+			})
+
+			if errs.IsA[*types.NotFoundException](err) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("deleting deployment (%s): %w", id, err)
+			}
+		}
+
+		d.Set("deployment_ids", retained)
+	}
+
+	return nil
+}
+
+// syncRestAPIDeploymentStage points a stage at a deployment, creating the stage if it doesn't exist.
+func syncRestAPIDeploymentStage(ctx context.Context, conn *apigateway.Client, apiID, stageName, deploymentID string, variables, canarySettings map[string]interface{}) error {
+	stage, err := findStageByTwoPartKey(ctx, conn, apiID, stageName)
+
+	if tfresource.NotFound(err) {
+		// There is nothing to compare a canary against in a new stage, so it serves the deployment directly.
+		input := &apigateway.CreateStageInput{
+			DeploymentId: aws.String(deploymentID),
+			RestApiId:    aws.String(apiID),
+			StageName:    aws.String(stageName),
+		}
+
+		if len(variables) > 0 {
+			input.Variables = flex.ExpandStringValueMap(variables)
+		}
+
+		if _, err := conn.CreateStage(ctx, input); err != nil {
+			return fmt.Errorf("creating stage (%s): %w", stageName, err)
+		}
+
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading stage (%s): %w", stageName, err)
+	}
+
+	if operations := stageDeploymentPatchOperations(stage, deploymentID, variables, canarySettings); len(operations) > 0 {
+		_, err := conn.UpdateStage(ctx, &apigateway.UpdateStageInput{
+			PatchOperations: operations,
+			RestApiId:       aws.String(apiID),
+			StageName:       aws.String(stageName),
+		})
+
+		if err != nil {
+			return fmt.Errorf("updating stage (%s): %w", stageName, err)
+		}
+	}
+
+	return nil
+}
+
+// stageDeploymentPatchOperations returns the operations that point a stage at a deployment.
+// Without canary settings the stage serves the deployment directly and any existing canary is promoted.
+func stageDeploymentPatchOperations(stage *apigateway.GetStageOutput, deploymentID string, variables, canarySettings map[string]interface{}) []types.PatchOperation {
+	operations := diffVariablesOps(flex.FlattenStringValueMap(stage.Variables), variables, "/variables/")
+
+	switch {
+	case aws.ToString(stage.DeploymentId) == deploymentID:
+		if stage.CanarySettings != nil {
+			operations = append(operations, types.PatchOperation{
+				Op:   types.OpRemove,
+				Path: aws.String("/canarySettings"),
+			})
+		}
+	case canarySettings != nil:
+		var oldCanarySettings []interface{}
+		if v := stage.CanarySettings; v != nil {
+			oldCanarySettings = []interface{}{map[string]interface{}{
+				"percent_traffic":          v.PercentTraffic,
+				"stage_variable_overrides": flex.FlattenStringValueMap(v.StageVariableOverrides),
+				"use_stage_cache":          v.UseStageCache,
+			}}
+		}
+
+		if stage.CanarySettings == nil || aws.ToString(stage.CanarySettings.DeploymentId) != deploymentID {
+			operations = append(operations, types.PatchOperation{
+				Op:    types.OpReplace,
+				Path:  aws.String("/canarySettings/deploymentId"),
+				Value: aws.String(deploymentID),
+			})
+		}
+
+		operations = appendCanarySettingsPatchOperations(operations, oldCanarySettings, []interface{}{canarySettings})
+	default:
+		operations = append(operations, types.PatchOperation{
+			Op:    types.OpReplace,
+			Path:  aws.String("/deploymentId"),
+			Value: aws.String(deploymentID),
+		})
+
+		if stage.CanarySettings != nil {
+			operations = append(operations, types.PatchOperation{
+				Op:   types.OpRemove,
+				Path: aws.String("/canarySettings"),
+			})
+		}
+	}
+
+	return operations
+}
+
 func expandDeploymentCanarySettings(tfMap map[string]interface{}) *types.DeploymentCanarySettings {
 	if tfMap == nil {
 		return nil
@@ -358,6 +358,62 @@ func TestAccAPIGatewayDeployment_deploymentCanarySettings(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayDeployment_deploymentCanarySettingsStageName(t *testing.T) {
+	ctx := acctest.Context(t)
+	var deployment apigateway.GetDeploymentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	url := "https://example.com"
+	resourceName := "aws_api_gateway_deployment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_canarySettingsStageName(rName, url),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment),
+					testAccCheckDeploymentStageCanary(ctx, resourceName, 33.33),
+					resource.TestCheckResourceAttr(resourceName, "canary_settings.0.percent_traffic", "33.33"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDeploymentStageCanary(ctx context.Context, n string, percentTraffic float64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayClient(ctx)
+
+		output, err := tfapigateway.FindStageByTwoPartKey(ctx, conn, rs.Primary.Attributes["rest_api_id"], rs.Primary.Attributes["stage_name"])
+
+		if err != nil {
+			return err
+		}
+
+		if output.CanarySettings == nil {
+			return fmt.Errorf("API Gateway Stage (%s) has no canary settings", rs.Primary.Attributes["stage_name"])
+		}
+
+		if got, want := aws.ToString(output.CanarySettings.DeploymentId), rs.Primary.ID; got != want {
+			return fmt.Errorf("API Gateway Stage (%s) canary deployment ID = %s, want %s", rs.Primary.Attributes["stage_name"], got, want)
+		}
+
+		if got := output.CanarySettings.PercentTraffic; got != percentTraffic {
+			return fmt.Errorf("API Gateway Stage (%s) canary percent traffic = %v, want %v", rs.Primary.Attributes["stage_name"], got, percentTraffic)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckDeploymentExists(ctx context.Context, n string, v *apigateway.GetDeploymentOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -630,3 +686,25 @@ resource "aws_api_gateway_deployment" "test" {
 }
 `)
 }
+
+func testAccDeploymentConfig_canarySettingsStageName(rName, url string) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_base(rName, url), `
+resource "aws_api_gateway_deployment" "base" {
+  depends_on = [aws_api_gateway_integration.test]
+
+  rest_api_id = aws_api_gateway_rest_api.test.id
+  stage_name  = "test"
+}
+
+resource "aws_api_gateway_deployment" "test" {
+  depends_on = [aws_api_gateway_deployment.base]
+
+  rest_api_id = aws_api_gateway_rest_api.test.id
+  stage_name  = aws_api_gateway_deployment.base.stage_name
+
+  canary_settings {
+    percent_traffic = "33.33"
+  }
+}
+`)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -75,6 +76,73 @@ func resourceRestAPI() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deployment": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				RequiredWith: []string{"body"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"canary_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"percent_traffic": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										Default:      0.0,
+										ValidateFunc: validation.FloatBetween(0.0, 100.0),
+									},
+									"stage_variable_overrides": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"use_stage_cache": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"retention_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      5,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"stage_names": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+						},
+						"variables": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"deployment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -174,10 +242,42 @@ func resourceRestAPI() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceRestAPICustomizeDiff,
+		),
 	}
 }
 
+func resourceRestAPICustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := d.GetOk("deployment"); !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		if d.Get("deployment_id").(string) != "" {
+			if err := d.SetNewComputed("deployment_id"); err != nil {
+				return err
+			}
+
+			return d.SetNewComputed("deployment_ids")
+		}
+
+		return nil
+	}
+
+	// A new deployment is created when the API is created, when its specification changes or when managed deployments are enabled.
+	if d.Id() == "" || d.HasChanges("body", names.AttrParameters) || d.Get("deployment_id").(string) == "" {
+		if err := d.SetNewComputed("deployment_id"); err != nil {
+			return err
+		}
+
+		return d.SetNewComputed("deployment_ids")
+	}
+
+	if d.HasChange("deployment.0.retention_count") {
+		return d.SetNewComputed("deployment_ids")
+	}
+
+	return nil
+}
+
 func resourceRestAPICreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
@@ -276,6 +376,12 @@ func resourceRestAPICreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if _, ok := d.GetOk("deployment"); ok {
+		if err := syncRestAPIDeployment(ctx, conn, d); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deploying API Gateway REST API (%s): %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceRestAPIRead(ctx, d, meta)...)
 }
 
@@ -546,6 +652,18 @@ func resourceRestAPIUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChanges("body", names.AttrParameters, "deployment") {
+		if _, ok := d.GetOk("deployment"); ok {
+			if err := syncRestAPIDeployment(ctx, conn, d); err != nil {
+				return sdkdiag.AppendErrorf(diags, "deploying API Gateway REST API (%s): %s", d.Id(), err)
+			}
+		} else {
+			// Deployments and stages are left in place when managed deployments are disabled.
+			d.Set("deployment_id", nil)
+			d.Set("deployment_ids", nil)
+		}
+	}
+
 	return append(diags, resourceRestAPIRead(ctx, d, meta)...)
 }
 
@@ -495,6 +495,115 @@ func TestAccAPIGatewayRestAPI_body(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayRestAPI_deployment(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf apigateway.GetRestApiOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_rest_api.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRESTAPIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestAPIConfig_deployment(rName, "/test", 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRESTAPIExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "deployment.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "deployment.0.stage_names.#", acctest.Ct2),
+					resource.TestCheckResourceAttrSet(resourceName, "deployment_id"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "deployment_ids.0", resourceName, "deployment_id"),
+					testAccCheckRestAPIStageDeployment(ctx, resourceName, "dev", false),
+					testAccCheckRestAPIStageDeployment(ctx, resourceName, "prod", false),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"body", "deployment", "deployment_id", "deployment_ids", "put_rest_api_mode"},
+			},
+			{
+				Config: testAccRestAPIConfig_deployment(rName, "/update", 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRESTAPIExists(ctx, resourceName, &conf),
+					testAccCheckRestAPIRoutes(ctx, &conf, []string{"/", "/update"}),
+					resource.TestCheckResourceAttr(resourceName, "deployment_ids.#", acctest.Ct2),
+					resource.TestCheckResourceAttrPair(resourceName, "deployment_ids.0", resourceName, "deployment_id"),
+					testAccCheckRestAPIStageDeployment(ctx, resourceName, "dev", false),
+					testAccCheckRestAPIStageDeployment(ctx, resourceName, "prod", false),
+				),
+			},
+			{
+				Config: testAccRestAPIConfig_deployment(rName, "/retention", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRESTAPIExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "deployment.0.retention_count", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "deployment_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "deployment_ids.0", resourceName, "deployment_id"),
+					testAccCheckRestAPIStageDeployment(ctx, resourceName, "dev", false),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayRestAPI_Deployment_canary(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf apigateway.GetRestApiOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_rest_api.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRESTAPIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestAPIConfig_deployment(rName, "/test", 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRESTAPIExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "deployment_ids.#", acctest.Ct1),
+					testAccCheckRestAPIStageDeployment(ctx, resourceName, "prod", false),
+				),
+			},
+			{
+				Config: testAccRestAPIConfig_deploymentCanary(rName, "/canary", 25),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRESTAPIExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "deployment.0.canary_settings.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "deployment.0.canary_settings.0.percent_traffic", "25"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_ids.#", acctest.Ct2),
+					testAccCheckRestAPIStageDeployment(ctx, resourceName, "prod", true),
+				),
+			},
+			{
+				Config: testAccRestAPIConfig_deploymentCanary(rName, "/canary", 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRESTAPIExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "deployment.0.canary_settings.0.percent_traffic", "50"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_ids.#", acctest.Ct2),
+					testAccCheckRestAPIStageDeployment(ctx, resourceName, "prod", true),
+				),
+			},
+			// Removing the canary settings promotes the canary deployment.
+			{
+				Config: testAccRestAPIConfig_deployment(rName, "/canary", 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRESTAPIExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "deployment.0.canary_settings.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "deployment_ids.#", acctest.Ct2),
+					testAccCheckRestAPIStageDeployment(ctx, resourceName, "prod", false),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAPIGatewayRestAPI_description(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf apigateway.GetRestApiOutput
@@ -1434,6 +1543,49 @@ func testAccCheckRESTAPIExists(ctx context.Context, n string, v *apigateway.GetR
 	}
 }
 
+// testAccCheckRestAPIStageDeployment checks that the named stage serves the
+// REST API's managed deployment, either directly or as its canary.
+func testAccCheckRestAPIStageDeployment(ctx context.Context, n, stageName string, canary bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayClient(ctx)
+
+		stage, err := tfapigateway.FindStageByTwoPartKey(ctx, conn, rs.Primary.ID, stageName)
+
+		if err != nil {
+			return err
+		}
+
+		deploymentID := rs.Primary.Attributes["deployment_id"]
+
+		if canary {
+			if stage.CanarySettings == nil || aws.ToString(stage.CanarySettings.DeploymentId) != deploymentID {
+				return fmt.Errorf("API Gateway Stage (%s) canary does not serve deployment (%s)", stageName, deploymentID)
+			}
+
+			if aws.ToString(stage.DeploymentId) == deploymentID {
+				return fmt.Errorf("API Gateway Stage (%s) already serves canary deployment (%s)", stageName, deploymentID)
+			}
+
+			return nil
+		}
+
+		if stage.CanarySettings != nil {
+			return fmt.Errorf("API Gateway Stage (%s) has unexpected canary settings", stageName)
+		}
+
+		if got := aws.ToString(stage.DeploymentId); got != deploymentID {
+			return fmt.Errorf("API Gateway Stage (%s) serves deployment (%s), expected (%s)", stageName, got, deploymentID)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckRESTAPIDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayClient(ctx)
@@ -2247,6 +2399,76 @@ resource "aws_api_gateway_rest_api" "test" {
 `, rName, basePath)
 }
 
+func testAccRestAPIConfig_deploymentBody(rName, basePath string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test" {
+  name = %[1]q
+
+  body = jsonencode({
+    swagger = "2.0"
+    info = {
+      title   = "test"
+      version = "2017-04-20T04:08:08Z"
+    }
+    schemes = ["https"]
+    paths = {
+      %[2]q = {
+        get = {
+          responses = {
+            "200" = {
+              description = "OK"
+            }
+          }
+          x-amazon-apigateway-integration = {
+            type = "MOCK"
+            requestTemplates = {
+              "application/json" = "{\"statusCode\": 200}"
+            }
+            responses = {
+              default = {
+                statusCode = 200
+              }
+            }
+          }
+        }
+      }
+    }
+  })
+`, rName, basePath)
+}
+
+func testAccRestAPIConfig_deployment(rName, basePath string, retentionCount int) string {
+	return testAccRestAPIConfig_deploymentBody(rName, basePath) + fmt.Sprintf(`
+  deployment {
+    description     = "managed by Terraform"
+    retention_count = %[1]d
+    stage_names     = ["dev", "prod"]
+
+    variables = {
+      base_path = %[2]q
+    }
+  }
+}
+`, retentionCount, basePath)
+}
+
+func testAccRestAPIConfig_deploymentCanary(rName, basePath string, percentTraffic float64) string {
+	return testAccRestAPIConfig_deploymentBody(rName, basePath) + fmt.Sprintf(`
+  deployment {
+    stage_names = ["dev", "prod"]
+
+    canary_settings {
+      percent_traffic = %[1]g
+
+      stage_variable_overrides = {
+        canary = "true"
+      }
+    }
+  }
+}
+`, percentTraffic)
+}
+
 func testAccRestAPIConfig_description(rName string, description string) string {
 	return fmt.Sprintf(`
 resource "aws_api_gateway_rest_api" "test" {
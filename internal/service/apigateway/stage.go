@@ -459,6 +459,27 @@ func findStageByTwoPartKey(ctx context.Context, conn *apigateway.Client, apiID,
 	return output, nil
 }
 
+func findStages(ctx context.Context, conn *apigateway.Client, input *apigateway.GetStagesInput) ([]types.Stage, error) {
+	output, err := conn.GetStages(ctx, input)
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Item, nil
+}
+
 func stageCacheStatus(ctx context.Context, conn *apigateway.Client, restApiId, name string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findStageByTwoPartKey(ctx, conn, restApiId, name)
@@ -0,0 +1,12 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+// Exports for use in tests only.
+var (
+	ResourceServiceLevelObjective = newServiceLevelObjectiveResource
+
+	FindServiceLevelObjectiveByID = findServiceLevelObjectiveByID
+	FindServices                  = findServices
+)
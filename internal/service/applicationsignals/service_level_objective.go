@@ -0,0 +1,922 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_applicationsignals_service_level_objective", name="Service Level Objective")
+// @Tags(identifierAttribute="arn")
+func newServiceLevelObjectiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &serviceLevelObjectiveResource{}
+
+	return r, nil
+}
+
+type serviceLevelObjectiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*serviceLevelObjectiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_applicationsignals_service_level_objective"
+}
+
+func (r *serviceLevelObjectiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"created_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1024),
+				},
+			},
+			"evaluation_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EvaluationType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"last_updated_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"burn_rate_configurations": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[burnRateConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(10),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"look_back_window_minutes": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 10080),
+							},
+						},
+					},
+				},
+			},
+			"goal": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[goalModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"attainment_goal": schema.Float64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Float64{
+								float64planmodifier.UseStateForUnknown(),
+							},
+						},
+						"warning_threshold": schema.Float64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Float64{
+								float64planmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"interval": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[intervalModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"calendar_interval": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[calendarIntervalModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+											listvalidator.ExactlyOneOf(
+												path.MatchRelative().AtParent().AtName("rolling_interval"),
+											),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrDuration: schema.Int64Attribute{
+													Required: true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(1),
+													},
+												},
+												"duration_unit": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.DurationUnit](),
+													Required:   true,
+												},
+												names.AttrStartTime: schema.StringAttribute{
+													CustomType: timetypes.RFC3339Type{},
+													Required:   true,
+												},
+											},
+										},
+									},
+									"rolling_interval": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[rollingIntervalModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+											listvalidator.ExactlyOneOf(
+												path.MatchRelative().AtParent().AtName("calendar_interval"),
+											),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrDuration: schema.Int64Attribute{
+													Required: true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(1),
+													},
+												},
+												"duration_unit": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.DurationUnit](),
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"request_based_sli_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[requestBasedSLIConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.ExactlyOneOf(
+						path.MatchRoot("sli_config"),
+					),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"comparison_operator": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorComparisonOperator](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"metric_threshold": schema.Float64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Float64{
+								float64planmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"request_based_sli_metric_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[requestBasedSLIMetricConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key_attributes": schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										Optional:    true,
+										ElementType: types.StringType,
+									},
+									"metric_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorMetricType](),
+										Optional:   true,
+									},
+									"operation_name": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"monitored_request_count_metric": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[monitoredRequestCountMetricModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"bad_count_metric": metricDataQueriesBlock(ctx,
+													listvalidator.ExactlyOneOf(
+														path.MatchRelative().AtParent().AtName("good_count_metric"),
+													),
+												),
+												"good_count_metric": metricDataQueriesBlock(ctx,
+													listvalidator.ExactlyOneOf(
+														path.MatchRelative().AtParent().AtName("bad_count_metric"),
+													),
+												),
+											},
+										},
+									},
+									"total_request_count_metric": metricDataQueriesBlock(ctx),
+								},
+							},
+						},
+					},
+				},
+			},
+			"sli_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[sliConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"comparison_operator": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorComparisonOperator](),
+							Required:   true,
+						},
+						"metric_threshold": schema.Float64Attribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"sli_metric_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[sliMetricConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key_attributes": schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										Optional:    true,
+										ElementType: types.StringType,
+									},
+									"metric_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorMetricType](),
+										Optional:   true,
+									},
+									"operation_name": schema.StringAttribute{
+										Optional: true,
+									},
+									"period_seconds": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.Between(60, 900),
+										},
+									},
+									"statistic": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"metric_data_queries": metricDataQueriesBlock(ctx),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func metricDataQueriesBlock(ctx context.Context, validators ...validator.List) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[metricDataQueryModel](ctx),
+		Validators: append([]validator.List{
+			listvalidator.SizeAtMost(20),
+		}, validators...),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrAccountID: schema.StringAttribute{
+					Optional: true,
+				},
+				names.AttrExpression: schema.StringAttribute{
+					Optional: true,
+				},
+				names.AttrID: schema.StringAttribute{
+					Required: true,
+				},
+				"label": schema.StringAttribute{
+					Optional: true,
+				},
+				"period": schema.Int64Attribute{
+					Optional: true,
+				},
+				"return_data": schema.BoolAttribute{
+					Optional: true,
+					Computed: true,
+					PlanModifiers: []planmodifier.Bool{
+						boolplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"metric_stat": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[metricStatModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"period": schema.Int64Attribute{
+								Required: true,
+							},
+							"stat": schema.StringAttribute{
+								Required: true,
+							},
+							names.AttrUnit: schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.StandardUnit](),
+								Optional:   true,
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"metric": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[metricModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										names.AttrMetricName: schema.StringAttribute{
+											Optional: true,
+										},
+										names.AttrNamespace: schema.StringAttribute{
+											Optional: true,
+										},
+									},
+									Blocks: map[string]schema.Block{
+										"dimensions": schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[dimensionModel](ctx),
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(30),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													names.AttrName: schema.StringAttribute{
+														Required: true,
+													},
+													names.AttrValue: schema.StringAttribute{
+														Required: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *serviceLevelObjectiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data serviceLevelObjectiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &applicationsignals.CreateServiceLevelObjectiveInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateServiceLevelObjective(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Application Signals Service Level Objective (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	slo := output.Slo
+	data.ID = fwflex.StringToFramework(ctx, slo.Arn)
+	response.Diagnostics.Append(data.flatten(ctx, slo)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *serviceLevelObjectiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data serviceLevelObjectiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	output, err := findServiceLevelObjectiveByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Application Signals Service Level Objective (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *serviceLevelObjectiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new serviceLevelObjectiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	if !new.BurnRateConfigurations.Equal(old.BurnRateConfigurations) ||
+		!new.Description.Equal(old.Description) ||
+		!new.Goal.Equal(old.Goal) ||
+		!new.RequestBasedSLIConfig.Equal(old.RequestBasedSLIConfig) ||
+		!new.SLIConfig.Equal(old.SLIConfig) {
+		input := &applicationsignals.UpdateServiceLevelObjectiveInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// An empty list removes all burn rate configurations.
+		if input.BurnRateConfigurations == nil && !old.BurnRateConfigurations.IsNull() {
+			input.BurnRateConfigurations = []awstypes.BurnRateConfiguration{}
+		}
+
+		output, err := conn.UpdateServiceLevelObjective(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Application Signals Service Level Objective (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.flatten(ctx, output.Slo)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.LastUpdatedTime = old.LastUpdatedTime
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *serviceLevelObjectiveResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data serviceLevelObjectiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	_, err := conn.DeleteServiceLevelObjective(ctx, &applicationsignals.DeleteServiceLevelObjectiveInput{
+		Id: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Application Signals Service Level Objective (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *serviceLevelObjectiveResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findServiceLevelObjectiveByID(ctx context.Context, conn *applicationsignals.Client, id string) (*awstypes.ServiceLevelObjective, error) {
+	input := &applicationsignals.GetServiceLevelObjectiveInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetServiceLevelObjective(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Slo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Slo, nil
+}
+
+type serviceLevelObjectiveResourceModel struct {
+	ARN                    types.String                                                `tfsdk:"arn"`
+	BurnRateConfigurations fwtypes.ListNestedObjectValueOf[burnRateConfigurationModel] `tfsdk:"burn_rate_configurations"`
+	CreatedTime            timetypes.RFC3339                                           `tfsdk:"created_time"`
+	Description            types.String                                                `tfsdk:"description"`
+	EvaluationType         fwtypes.StringEnum[awstypes.EvaluationType]                 `tfsdk:"evaluation_type"`
+	Goal                   fwtypes.ListNestedObjectValueOf[goalModel]                  `tfsdk:"goal"`
+	ID                     types.String                                                `tfsdk:"id"`
+	LastUpdatedTime        timetypes.RFC3339                                           `tfsdk:"last_updated_time"`
+	Name                   types.String                                                `tfsdk:"name"`
+	RequestBasedSLIConfig  fwtypes.ListNestedObjectValueOf[requestBasedSLIConfigModel] `tfsdk:"request_based_sli_config"`
+	SLIConfig              fwtypes.ListNestedObjectValueOf[sliConfigModel]             `tfsdk:"sli_config"`
+	Tags                   tftags.Map                                                  `tfsdk:"tags"`
+	TagsAll                tftags.Map                                                  `tfsdk:"tags_all"`
+}
+
+// flatten refreshes the model from the API's view of the SLO.
+// The SLI is returned in a different shape to the one used to configure it (e.g. the period and statistic are not returned
+// and Application Signals generates its own metric queries for service-based SLIs), so only the fields that round-trip are refreshed.
+func (m *serviceLevelObjectiveResourceModel) flatten(ctx context.Context, apiObject *awstypes.ServiceLevelObjective) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if v := apiObject.Sli; v == nil {
+		m.SLIConfig = fwtypes.NewListNestedObjectValueOfNull[sliConfigModel](ctx)
+	} else {
+		sliConfig, d := m.SLIConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		imported := sliConfig == nil
+		if imported {
+			sliConfig = &sliConfigModel{
+				SLIMetricConfig: fwtypes.NewListNestedObjectValueOfNull[sliMetricConfigModel](ctx),
+			}
+		}
+
+		sliConfig.ComparisonOperator = fwtypes.StringEnumValue(v.ComparisonOperator)
+		sliConfig.MetricThreshold = fwflex.Float64ToFramework(ctx, v.MetricThreshold)
+
+		if v := v.SliMetric; v != nil {
+			sliMetricConfig, d := sliConfig.SLIMetricConfig.ToPtr(ctx)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+			if sliMetricConfig == nil {
+				sliMetricConfig = &sliMetricConfigModel{
+					KeyAttributes:     fwtypes.NewMapValueOfNull[types.String](ctx),
+					MetricDataQueries: fwtypes.NewListNestedObjectValueOfNull[metricDataQueryModel](ctx),
+				}
+			}
+
+			metricDataQueries := sliMetricConfig.MetricDataQueries
+			diags.Append(fwflex.Flatten(ctx, v, sliMetricConfig)...)
+			if diags.HasError() {
+				return diags
+			}
+			if !imported && metricDataQueries.IsNull() {
+				sliMetricConfig.MetricDataQueries = metricDataQueries
+			}
+
+			sliConfig.SLIMetricConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, sliMetricConfig)
+		}
+
+		m.SLIConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, sliConfig)
+	}
+
+	if v := apiObject.RequestBasedSli; v == nil {
+		m.RequestBasedSLIConfig = fwtypes.NewListNestedObjectValueOfNull[requestBasedSLIConfigModel](ctx)
+	} else {
+		requestBasedSLIConfig, d := m.RequestBasedSLIConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		imported := requestBasedSLIConfig == nil
+		if imported {
+			requestBasedSLIConfig = &requestBasedSLIConfigModel{
+				RequestBasedSLIMetricConfig: fwtypes.NewListNestedObjectValueOfNull[requestBasedSLIMetricConfigModel](ctx),
+			}
+		}
+
+		requestBasedSLIConfig.ComparisonOperator = fwtypes.StringEnumValue(v.ComparisonOperator)
+		requestBasedSLIConfig.MetricThreshold = fwflex.Float64ToFramework(ctx, v.MetricThreshold)
+
+		if v := v.RequestBasedSliMetric; v != nil {
+			requestBasedSLIMetricConfig, d := requestBasedSLIConfig.RequestBasedSLIMetricConfig.ToPtr(ctx)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+			if requestBasedSLIMetricConfig == nil {
+				requestBasedSLIMetricConfig = &requestBasedSLIMetricConfigModel{
+					KeyAttributes:               fwtypes.NewMapValueOfNull[types.String](ctx),
+					MonitoredRequestCountMetric: fwtypes.NewListNestedObjectValueOfNull[monitoredRequestCountMetricModel](ctx),
+					TotalRequestCountMetric:     fwtypes.NewListNestedObjectValueOfNull[metricDataQueryModel](ctx),
+				}
+			}
+
+			monitoredRequestCountMetric, totalRequestCountMetric := requestBasedSLIMetricConfig.MonitoredRequestCountMetric, requestBasedSLIMetricConfig.TotalRequestCountMetric
+			diags.Append(fwflex.Flatten(ctx, v, requestBasedSLIMetricConfig)...)
+			if diags.HasError() {
+				return diags
+			}
+			if !imported && monitoredRequestCountMetric.IsNull() {
+				requestBasedSLIMetricConfig.MonitoredRequestCountMetric = monitoredRequestCountMetric
+			}
+			if !imported && totalRequestCountMetric.IsNull() {
+				requestBasedSLIMetricConfig.TotalRequestCountMetric = totalRequestCountMetric
+			}
+
+			requestBasedSLIConfig.RequestBasedSLIMetricConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, requestBasedSLIMetricConfig)
+		}
+
+		m.RequestBasedSLIConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, requestBasedSLIConfig)
+	}
+
+	return diags
+}
+
+type burnRateConfigurationModel struct {
+	LookBackWindowMinutes types.Int64 `tfsdk:"look_back_window_minutes"`
+}
+
+type goalModel struct {
+	AttainmentGoal   types.Float64                                  `tfsdk:"attainment_goal"`
+	Interval         fwtypes.ListNestedObjectValueOf[intervalModel] `tfsdk:"interval"`
+	WarningThreshold types.Float64                                  `tfsdk:"warning_threshold"`
+}
+
+type intervalModel struct {
+	CalendarInterval fwtypes.ListNestedObjectValueOf[calendarIntervalModel] `tfsdk:"calendar_interval"`
+	RollingInterval  fwtypes.ListNestedObjectValueOf[rollingIntervalModel]  `tfsdk:"rolling_interval"`
+}
+
+var (
+	_ fwflex.Expander  = intervalModel{}
+	_ fwflex.Flattener = &intervalModel{}
+)
+
+func (m intervalModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.CalendarInterval.IsNull():
+		calendarIntervalModel := fwdiag.Must(m.CalendarInterval.ToPtr(ctx))
+		var r awstypes.IntervalMemberCalendarInterval
+		diags.Append(fwflex.Expand(ctx, calendarIntervalModel, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.RollingInterval.IsNull():
+		rollingIntervalModel := fwdiag.Must(m.RollingInterval.ToPtr(ctx))
+		var r awstypes.IntervalMemberRollingInterval
+		diags.Append(fwflex.Expand(ctx, rollingIntervalModel, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *intervalModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	m.CalendarInterval = fwtypes.NewListNestedObjectValueOfNull[calendarIntervalModel](ctx)
+	m.RollingInterval = fwtypes.NewListNestedObjectValueOfNull[rollingIntervalModel](ctx)
+
+	switch t := v.(type) {
+	case awstypes.IntervalMemberCalendarInterval:
+		var model calendarIntervalModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.CalendarInterval = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.IntervalMemberRollingInterval:
+		var model rollingIntervalModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.RollingInterval = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type calendarIntervalModel struct {
+	Duration     types.Int64                               `tfsdk:"duration"`
+	DurationUnit fwtypes.StringEnum[awstypes.DurationUnit] `tfsdk:"duration_unit"`
+	StartTime    timetypes.RFC3339                         `tfsdk:"start_time"`
+}
+
+type rollingIntervalModel struct {
+	Duration     types.Int64                               `tfsdk:"duration"`
+	DurationUnit fwtypes.StringEnum[awstypes.DurationUnit] `tfsdk:"duration_unit"`
+}
+
+type sliConfigModel struct {
+	ComparisonOperator fwtypes.StringEnum[awstypes.ServiceLevelIndicatorComparisonOperator] `tfsdk:"comparison_operator"`
+	MetricThreshold    types.Float64                                                        `tfsdk:"metric_threshold"`
+	SLIMetricConfig    fwtypes.ListNestedObjectValueOf[sliMetricConfigModel]                `tfsdk:"sli_metric_config"`
+}
+
+type sliMetricConfigModel struct {
+	KeyAttributes     fwtypes.MapValueOf[types.String]                             `tfsdk:"key_attributes"`
+	MetricDataQueries fwtypes.ListNestedObjectValueOf[metricDataQueryModel]        `tfsdk:"metric_data_queries"`
+	MetricType        fwtypes.StringEnum[awstypes.ServiceLevelIndicatorMetricType] `tfsdk:"metric_type"`
+	OperationName     types.String                                                 `tfsdk:"operation_name"`
+	PeriodSeconds     types.Int64                                                  `tfsdk:"period_seconds"`
+	Statistic         types.String                                                 `tfsdk:"statistic"`
+}
+
+type requestBasedSLIConfigModel struct {
+	ComparisonOperator          fwtypes.StringEnum[awstypes.ServiceLevelIndicatorComparisonOperator] `tfsdk:"comparison_operator"`
+	MetricThreshold             types.Float64                                                        `tfsdk:"metric_threshold"`
+	RequestBasedSLIMetricConfig fwtypes.ListNestedObjectValueOf[requestBasedSLIMetricConfigModel]    `tfsdk:"request_based_sli_metric_config"`
+}
+
+type requestBasedSLIMetricConfigModel struct {
+	KeyAttributes               fwtypes.MapValueOf[types.String]                                  `tfsdk:"key_attributes"`
+	MetricType                  fwtypes.StringEnum[awstypes.ServiceLevelIndicatorMetricType]      `tfsdk:"metric_type"`
+	MonitoredRequestCountMetric fwtypes.ListNestedObjectValueOf[monitoredRequestCountMetricModel] `tfsdk:"monitored_request_count_metric"`
+	OperationName               types.String                                                      `tfsdk:"operation_name"`
+	TotalRequestCountMetric     fwtypes.ListNestedObjectValueOf[metricDataQueryModel]             `tfsdk:"total_request_count_metric"`
+}
+
+type monitoredRequestCountMetricModel struct {
+	BadCountMetric  fwtypes.ListNestedObjectValueOf[metricDataQueryModel] `tfsdk:"bad_count_metric"`
+	GoodCountMetric fwtypes.ListNestedObjectValueOf[metricDataQueryModel] `tfsdk:"good_count_metric"`
+}
+
+var (
+	_ fwflex.Expander  = monitoredRequestCountMetricModel{}
+	_ fwflex.Flattener = &monitoredRequestCountMetricModel{}
+)
+
+func (m monitoredRequestCountMetricModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.BadCountMetric.IsNull():
+		var r awstypes.MonitoredRequestCountMetricDataQueriesMemberBadCountMetric
+		diags.Append(fwflex.Expand(ctx, m.BadCountMetric, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.GoodCountMetric.IsNull():
+		var r awstypes.MonitoredRequestCountMetricDataQueriesMemberGoodCountMetric
+		diags.Append(fwflex.Expand(ctx, m.GoodCountMetric, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *monitoredRequestCountMetricModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	m.BadCountMetric = fwtypes.NewListNestedObjectValueOfNull[metricDataQueryModel](ctx)
+	m.GoodCountMetric = fwtypes.NewListNestedObjectValueOfNull[metricDataQueryModel](ctx)
+
+	switch t := v.(type) {
+	case awstypes.MonitoredRequestCountMetricDataQueriesMemberBadCountMetric:
+		diags.Append(fwflex.Flatten(ctx, t.Value, &m.BadCountMetric)...)
+
+		return diags
+
+	case awstypes.MonitoredRequestCountMetricDataQueriesMemberGoodCountMetric:
+		diags.Append(fwflex.Flatten(ctx, t.Value, &m.GoodCountMetric)...)
+
+		return diags
+	}
+
+	return diags
+}
+
+type metricDataQueryModel struct {
+	AccountID  types.String                                     `tfsdk:"account_id"`
+	Expression types.String                                     `tfsdk:"expression"`
+	ID         types.String                                     `tfsdk:"id"`
+	Label      types.String                                     `tfsdk:"label"`
+	MetricStat fwtypes.ListNestedObjectValueOf[metricStatModel] `tfsdk:"metric_stat"`
+	Period     types.Int64                                      `tfsdk:"period"`
+	ReturnData types.Bool                                       `tfsdk:"return_data"`
+}
+
+type metricStatModel struct {
+	Metric fwtypes.ListNestedObjectValueOf[metricModel] `tfsdk:"metric"`
+	Period types.Int64                                  `tfsdk:"period"`
+	Stat   types.String                                 `tfsdk:"stat"`
+	Unit   fwtypes.StringEnum[awstypes.StandardUnit]    `tfsdk:"unit"`
+}
+
+type metricModel struct {
+	Dimensions fwtypes.ListNestedObjectValueOf[dimensionModel] `tfsdk:"dimensions"`
+	MetricName types.String                                    `tfsdk:"metric_name"`
+	Namespace  types.String                                    `tfsdk:"namespace"`
+}
+
+type dimensionModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
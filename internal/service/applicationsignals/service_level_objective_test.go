@@ -0,0 +1,506 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfapplicationsignals "github.com/hashicorp/terraform-provider-aws/internal/service/applicationsignals"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccApplicationSignalsServiceLevelObjective_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "application-signals", regexache.MustCompile(`slo/.+$`)),
+					acctest.CheckResourceAttrRFC3339(resourceName, "created_time"),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_type", "PeriodBased"),
+					resource.TestCheckResourceAttr(resourceName, "goal.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.attainment_goal", "99"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.rolling_interval.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.rolling_interval.0.duration", "7"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.rolling_interval.0.duration_unit", "DAY"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.warning_threshold", "50"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli_config.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "sli_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "sli_config.0.comparison_operator", "LessThan"),
+					resource.TestCheckResourceAttr(resourceName, "sli_config.0.metric_threshold", "2000"),
+					resource.TestCheckResourceAttr(resourceName, "sli_config.0.sli_metric_config.0.metric_data_queries.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"sli_config.0.sli_metric_config.0.period_seconds",
+					"sli_config.0.sli_metric_config.0.statistic",
+				},
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfapplicationsignals.ResourceServiceLevelObjective, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.attainment_goal", "99"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.rolling_interval.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "sli_config.0.metric_threshold", "2000"),
+				),
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.attainment_goal", "99.9"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.calendar_interval.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.calendar_interval.0.duration", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.calendar_interval.0.duration_unit", "MONTH"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.calendar_interval.0.start_time", "2024-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.rolling_interval.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.warning_threshold", "30"),
+					resource.TestCheckResourceAttr(resourceName, "sli_config.0.metric_threshold", "1000"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_requestBased(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_requestBased(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_type", "RequestBased"),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli_config.0.request_based_sli_metric_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli_config.0.request_based_sli_metric_config.0.monitored_request_count_metric.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli_config.0.request_based_sli_metric_config.0.monitored_request_count_metric.0.bad_count_metric.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli_config.0.request_based_sli_metric_config.0.monitored_request_count_metric.0.good_count_metric.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli_config.0.request_based_sli_metric_config.0.total_request_count_metric.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "sli_config.#", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_burnRateConfigurations(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_burnRateConfigurations(rName, 60, 360),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configurations.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configurations.0.look_back_window_minutes", "60"),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configurations.1.look_back_window_minutes", "360"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_burnRateConfigurations(rName, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configurations.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configurations.0.look_back_window_minutes", "60"),
+				),
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configurations.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"sli_config.0.sli_metric_config.0.period_seconds",
+					"sli_config.0.sli_metric_config.0.statistic",
+				},
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckServiceLevelObjectiveDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationSignalsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_applicationsignals_service_level_objective" {
+				continue
+			}
+
+			_, err := tfapplicationsignals.FindServiceLevelObjectiveByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Application Signals Service Level Objective %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckServiceLevelObjectiveExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationSignalsClient(ctx)
+
+		_, err := tfapplicationsignals.FindServiceLevelObjectiveByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccServiceLevelObjectiveConfig_sliConfig(rName string, threshold int) string {
+	return fmt.Sprintf(`
+  sli_config {
+    comparison_operator = "LessThan"
+    metric_threshold    = %[2]d
+
+    sli_metric_config {
+      period_seconds = 60
+
+      metric_data_queries {
+        id          = "m1"
+        return_data = true
+
+        metric_stat {
+          period = 60
+          stat   = "Average"
+
+          metric {
+            metric_name = "Duration"
+            namespace   = "AWS/Lambda"
+
+            dimensions {
+              name  = "FunctionName"
+              value = %[1]q
+            }
+          }
+        }
+      }
+    }
+  }
+`, rName, threshold)
+}
+
+func testAccServiceLevelObjectiveConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+  goal {
+    interval {
+      rolling_interval {
+        duration      = 7
+        duration_unit = "DAY"
+      }
+    }
+  }
+%[2]s
+}
+`, rName, testAccServiceLevelObjectiveConfig_sliConfig(rName, 2000))
+}
+
+func testAccServiceLevelObjectiveConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name        = %[1]q
+  description = "updated"
+
+  goal {
+    attainment_goal   = 99.9
+    warning_threshold = 30
+
+    interval {
+      calendar_interval {
+        duration      = 1
+        duration_unit = "MONTH"
+        start_time    = "2024-01-01T00:00:00Z"
+      }
+    }
+  }
+%[2]s
+}
+`, rName, testAccServiceLevelObjectiveConfig_sliConfig(rName, 1000))
+}
+
+func testAccServiceLevelObjectiveConfig_burnRateConfigurations(rName string, lookBackWindowMinutes ...int) string {
+	var burnRateConfigurations strings.Builder
+	for _, v := range lookBackWindowMinutes {
+		fmt.Fprintf(&burnRateConfigurations, `
+  burn_rate_configurations {
+    look_back_window_minutes = %[1]d
+  }
+`, v)
+	}
+
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+  goal {
+    interval {
+      rolling_interval {
+        duration      = 7
+        duration_unit = "DAY"
+      }
+    }
+  }
+%[2]s%[3]s
+}
+`, rName, burnRateConfigurations.String(), testAccServiceLevelObjectiveConfig_sliConfig(rName, 2000))
+}
+
+func testAccServiceLevelObjectiveConfig_requestBased(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+  goal {
+    interval {
+      rolling_interval {
+        duration      = 1
+        duration_unit = "DAY"
+      }
+    }
+  }
+
+  request_based_sli_config {
+    request_based_sli_metric_config {
+      monitored_request_count_metric {
+        bad_count_metric {
+          id          = "errors"
+          return_data = true
+
+          metric_stat {
+            period = 60
+            stat   = "Sum"
+
+            metric {
+              metric_name = "Errors"
+              namespace   = "AWS/Lambda"
+
+              dimensions {
+                name  = "FunctionName"
+                value = %[1]q
+              }
+            }
+          }
+        }
+      }
+
+      total_request_count_metric {
+        id          = "invocations"
+        return_data = true
+
+        metric_stat {
+          period = 60
+          stat   = "Sum"
+
+          metric {
+            metric_name = "Invocations"
+            namespace   = "AWS/Lambda"
+
+            dimensions {
+              name  = "FunctionName"
+              value = %[1]q
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccServiceLevelObjectiveConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+  goal {
+    interval {
+      rolling_interval {
+        duration      = 7
+        duration_unit = "DAY"
+      }
+    }
+  }
+%[2]s
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, testAccServiceLevelObjectiveConfig_sliConfig(rName, 2000), tagKey1, tagValue1)
+}
+
+func testAccServiceLevelObjectiveConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+  goal {
+    interval {
+      rolling_interval {
+        duration      = 7
+        duration_unit = "DAY"
+      }
+    }
+  }
+%[2]s
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, testAccServiceLevelObjectiveConfig_sliConfig(rName, 2000), tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_applicationsignals_service_operations", name="Service Operations")
+func newServiceOperationsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &serviceOperationsDataSource{}, nil
+}
+
+type serviceOperationsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *serviceOperationsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_applicationsignals_service_operations"
+}
+
+func (d *serviceOperationsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"end_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"key_attributes": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"service_operations": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[serviceOperationModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[serviceOperationModel](ctx),
+			},
+			names.AttrStartTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *serviceOperationsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data serviceOperationsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ApplicationSignalsClient(ctx)
+
+	startTime, endTime, diags := expandTimeRange(ctx, data.StartTime, data.EndTime)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &applicationsignals.ListServiceOperationsInput{
+		EndTime:       aws.Time(endTime),
+		KeyAttributes: fwflex.ExpandFrameworkStringValueMap(ctx, data.KeyAttributes),
+		StartTime:     aws.Time(startTime),
+	}
+
+	output, err := findServiceOperations(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Application Signals Service Operations", err.Error())
+
+		return
+	}
+
+	data.EndTime = timetypes.NewRFC3339TimeValue(endTime)
+	data.ID = fwflex.StringValueToFramework(ctx, d.Meta().Region)
+	data.StartTime = timetypes.NewRFC3339TimeValue(startTime)
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.ServiceOperations)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findServiceOperations(ctx context.Context, conn *applicationsignals.Client, input *applicationsignals.ListServiceOperationsInput) ([]awstypes.ServiceOperation, error) {
+	var output []awstypes.ServiceOperation
+
+	pages := applicationsignals.NewListServiceOperationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ServiceOperations...)
+	}
+
+	return output, nil
+}
+
+type serviceOperationsDataSourceModel struct {
+	EndTime           timetypes.RFC3339                                      `tfsdk:"end_time"`
+	ID                types.String                                           `tfsdk:"id"`
+	KeyAttributes     fwtypes.MapValueOf[types.String]                       `tfsdk:"key_attributes"`
+	ServiceOperations fwtypes.ListNestedObjectValueOf[serviceOperationModel] `tfsdk:"service_operations"`
+	StartTime         timetypes.RFC3339                                      `tfsdk:"start_time"`
+}
+
+type serviceOperationModel struct {
+	MetricReferences fwtypes.ListNestedObjectValueOf[metricReferenceModel] `tfsdk:"metric_references"`
+	Name             types.String                                          `tfsdk:"name"`
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfapplicationsignals "github.com/hashicorp/terraform-provider-aws/internal/service/applicationsignals"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccApplicationSignalsServiceOperationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_applicationsignals_service_operations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDiscoveredServices(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceOperationsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrRFC3339(dataSourceName, "end_time"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "key_attributes.%", "data.aws_applicationsignals_services.test", "services.0.key_attributes.%"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "service_operations.#"),
+					acctest.CheckResourceAttrRFC3339(dataSourceName, names.AttrStartTime),
+				),
+			},
+		},
+	})
+}
+
+// testAccPreCheckDiscoveredServices skips the test unless Application Signals has discovered at least one service.
+func testAccPreCheckDiscoveredServices(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationSignalsClient(ctx)
+
+	endTime := time.Now()
+	input := &applicationsignals.ListServicesInput{
+		EndTime:   aws.Time(endTime),
+		StartTime: aws.Time(endTime.Add(-24 * time.Hour)),
+	}
+	output, err := tfapplicationsignals.FindServices(ctx, conn, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+
+	if len(output) == 0 {
+		t.Skip("skipping acceptance testing: no Application Signals services discovered")
+	}
+}
+
+const testAccServiceOperationsDataSourceConfig_basic = `
+data "aws_applicationsignals_services" "test" {}
+
+data "aws_applicationsignals_service_operations" "test" {
+  key_attributes = data.aws_applicationsignals_services.test.services[0].key_attributes
+}
+`
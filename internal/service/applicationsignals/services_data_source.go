@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_applicationsignals_services", name="Services")
+func newServicesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &servicesDataSource{}, nil
+}
+
+type servicesDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *servicesDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_applicationsignals_services"
+}
+
+func (d *servicesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"end_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"services": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[serviceSummaryModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[serviceSummaryModel](ctx),
+			},
+			names.AttrStartTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *servicesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data servicesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ApplicationSignalsClient(ctx)
+
+	startTime, endTime, diags := expandTimeRange(ctx, data.StartTime, data.EndTime)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &applicationsignals.ListServicesInput{
+		EndTime:   aws.Time(endTime),
+		StartTime: aws.Time(startTime),
+	}
+
+	output, err := findServices(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Application Signals Services", err.Error())
+
+		return
+	}
+
+	data.EndTime = timetypes.NewRFC3339TimeValue(endTime)
+	data.ID = fwflex.StringValueToFramework(ctx, d.Meta().Region)
+	data.StartTime = timetypes.NewRFC3339TimeValue(startTime)
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.Services)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// expandTimeRange returns the discovery time range to query.
+// The end time defaults to now and the start time to 24 hours before the end time.
+func expandTimeRange(_ context.Context, start, end timetypes.RFC3339) (time.Time, time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	endTime := time.Now()
+	if !end.IsNull() && !end.IsUnknown() {
+		v, d := end.ValueRFC3339Time()
+		diags.Append(d...)
+		if diags.HasError() {
+			return time.Time{}, time.Time{}, diags
+		}
+		endTime = v
+	}
+
+	startTime := endTime.Add(-24 * time.Hour)
+	if !start.IsNull() && !start.IsUnknown() {
+		v, d := start.ValueRFC3339Time()
+		diags.Append(d...)
+		if diags.HasError() {
+			return time.Time{}, time.Time{}, diags
+		}
+		startTime = v
+	}
+
+	return startTime, endTime, diags
+}
+
+func findServices(ctx context.Context, conn *applicationsignals.Client, input *applicationsignals.ListServicesInput) ([]awstypes.ServiceSummary, error) {
+	var output []awstypes.ServiceSummary
+
+	pages := applicationsignals.NewListServicesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ServiceSummaries...)
+	}
+
+	return output, nil
+}
+
+type servicesDataSourceModel struct {
+	EndTime   timetypes.RFC3339                                    `tfsdk:"end_time"`
+	ID        types.String                                         `tfsdk:"id"`
+	Services  fwtypes.ListNestedObjectValueOf[serviceSummaryModel] `tfsdk:"services"`
+	StartTime timetypes.RFC3339                                    `tfsdk:"start_time"`
+}
+
+type serviceSummaryModel struct {
+	KeyAttributes    fwtypes.MapValueOf[types.String]                      `tfsdk:"key_attributes"`
+	MetricReferences fwtypes.ListNestedObjectValueOf[metricReferenceModel] `tfsdk:"metric_references"`
+}
+
+type metricReferenceModel struct {
+	Dimensions fwtypes.ListNestedObjectValueOf[dimensionModel] `tfsdk:"dimensions"`
+	MetricName types.String                                    `tfsdk:"metric_name"`
+	MetricType types.String                                    `tfsdk:"metric_type"`
+	Namespace  types.String                                    `tfsdk:"namespace"`
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccApplicationSignalsServicesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_applicationsignals_services.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicesDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrRFC3339(dataSourceName, "end_time"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "services.#"),
+					acctest.CheckResourceAttrRFC3339(dataSourceName, names.AttrStartTime),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServicesDataSource_timeRange(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_applicationsignals_services.test"
+	endTime := time.Now().UTC().Truncate(time.Hour)
+	startTime := endTime.Add(-3 * time.Hour)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicesDataSourceConfig_timeRange(startTime.Format(time.RFC3339), endTime.Format(time.RFC3339)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "end_time", endTime.Format(time.RFC3339)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "services.#"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStartTime, startTime.Format(time.RFC3339)),
+				),
+			},
+		},
+	})
+}
+
+const testAccServicesDataSourceConfig_basic = `
+data "aws_applicationsignals_services" "test" {}
+`
+
+func testAccServicesDataSourceConfig_timeRange(startTime, endTime string) string {
+	return fmt.Sprintf(`
+data "aws_applicationsignals_services" "test" {
+  start_time = %[1]q
+  end_time   = %[2]q
+}
+`, startTime, endTime)
+}
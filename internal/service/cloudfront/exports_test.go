@@ -11,6 +11,7 @@ var (
 	ResourceFieldLevelEncryptionConfig  = resourceFieldLevelEncryptionConfig
 	ResourceFieldLevelEncryptionProfile = resourceFieldLevelEncryptionProfile
 	ResourceFunction                    = resourceFunction
+	ResourceInvalidation                = resourceInvalidation
 	ResourceKeyGroup                    = resourceKeyGroup
 	ResourceKeyValueStore               = newKeyValueStoreResource
 	ResourceMonitoringSubscription      = resourceMonitoringSubscription
@@ -27,6 +28,8 @@ var (
 	FindFieldLevelEncryptionConfigByID         = findFieldLevelEncryptionConfigByID
 	FindFieldLevelEncryptionProfileByID        = findFieldLevelEncryptionProfileByID
 	FindFunctionByTwoPartKey                   = findFunctionByTwoPartKey
+	FindInvalidationByTwoPartKey               = findInvalidationByTwoPartKey
+	FunctionOutputMatches                      = functionOutputMatches
 	FindKeyGroupByID                           = findKeyGroupByID
 	FindKeyValueStoreByName                    = findKeyValueStoreByName
 	FindMonitoringSubscriptionByDistributionID = findMonitoringSubscriptionByDistributionID
@@ -36,5 +39,6 @@ var (
 	FindPublicKeyByID                          = findPublicKeyByID
 	FindRealtimeLogConfigByARN                 = findRealtimeLogConfigByARN
 	FindResponseHeadersPolicyByID              = findResponseHeadersPolicyByID
+	InvalidationPathBatches                    = invalidationPathBatches
 	WaitDistributionDeployed                   = waitDistributionDeployed
 )
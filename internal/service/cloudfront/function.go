@@ -5,7 +5,9 @@ package cloudfront
 
 import (
 	"context"
+	"encoding/json"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
@@ -13,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -40,6 +43,11 @@ func resourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"block_publish_on_test_failure": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"code": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -83,6 +91,50 @@ func resourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"test_event": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compute_utilization": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_object": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsJSON,
+						},
+						"execution_logs": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"expected_output": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+						},
+						"function_error_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"output": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"passed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -113,6 +165,13 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.SetId(aws.ToString(output.FunctionSummary.Name))
 
+	if v, ok := d.GetOk("test_event"); ok && len(v.([]interface{})) > 0 {
+		// The function only exists in the DEVELOPMENT stage, so a failed test taints it.
+		if diags := testFunction(ctx, conn, d, aws.ToString(output.ETag)); diags.HasError() {
+			return diags
+		}
+	}
+
 	if d.Get("publish").(bool) {
 		input := &cloudfront.PublishFunctionInput{
 			Name:    aws.String(d.Id()),
@@ -208,6 +267,16 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		etag = aws.ToString(output.ETag)
 	}
 
+	if d.HasChanges("code", names.AttrComment, "key_value_store_associations", "runtime", "test_event") {
+		if v, ok := d.GetOk("test_event"); ok && len(v.([]interface{})) > 0 {
+			if diags := testFunction(ctx, conn, d, etag); diags.HasError() {
+				// Leave the prior test results in state so that the tests are run again.
+				d.Partial(true)
+				return diags
+			}
+		}
+	}
+
 	if d.Get("publish").(bool) {
 		input := &cloudfront.PublishFunctionInput{
 			IfMatch: aws.String(etag),
@@ -245,6 +314,125 @@ func resourceFunctionDelete(ctx context.Context, d *schema.ResourceData, meta in
 	return diags
 }
 
+// testFunction runs each configured test event against the function's DEVELOPMENT stage and records the results.
+// An error is returned if a test fails and block_publish_on_test_failure is set.
+func testFunction(ctx context.Context, conn *cloudfront.Client, d *schema.ResourceData, etag string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tfList := d.Get("test_event").([]interface{})
+	var failed []string
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := tfMap[names.AttrName].(string)
+		input := &cloudfront.TestFunctionInput{
+			EventObject: []byte(tfMap["event_object"].(string)),
+			IfMatch:     aws.String(etag),
+			Name:        aws.String(d.Id()),
+			Stage:       awstypes.FunctionStageDevelopment,
+		}
+
+		output, err := conn.TestFunction(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "testing CloudFront Function (%s) with test event (%s): %s", d.Id(), name, err)
+		}
+
+		result := output.TestResult
+		if result == nil {
+			result = &awstypes.TestResult{}
+		}
+
+		tfMap["compute_utilization"] = aws.ToString(result.ComputeUtilization)
+		tfMap["execution_logs"] = result.FunctionExecutionLogs
+		tfMap["function_error_message"] = aws.ToString(result.FunctionErrorMessage)
+		tfMap["output"] = aws.ToString(result.FunctionOutput)
+		tfMap["passed"] = functionTestPassed(tfMap["expected_output"].(string), result)
+
+		if !tfMap["passed"].(bool) {
+			failed = append(failed, name)
+		}
+
+		tfList[i] = tfMap
+	}
+
+	if err := d.Set("test_event", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting test_event: %s", err)
+	}
+
+	if len(failed) > 0 && d.Get("block_publish_on_test_failure").(bool) {
+		return sdkdiag.AppendErrorf(diags, "CloudFront Function (%s) test events failed, not publishing: %s", d.Id(), strings.Join(failed, ", "))
+	}
+
+	return diags
+}
+
+// functionTestPassed returns whether a test ran without a function error and, if an expected output is configured, produced a matching output.
+func functionTestPassed(expectedOutput string, result *awstypes.TestResult) bool {
+	if aws.ToString(result.FunctionErrorMessage) != "" {
+		return false
+	}
+
+	if expectedOutput == "" {
+		return true
+	}
+
+	return functionOutputMatches(expectedOutput, aws.ToString(result.FunctionOutput))
+}
+
+// functionOutputMatches returns whether every value in the expected JSON document is present in the actual one.
+// Object keys missing from expected are ignored, as the function output includes empty objects for unset fields.
+func functionOutputMatches(expected, actual string) bool {
+	var e, a interface{}
+
+	if err := json.Unmarshal([]byte(expected), &e); err != nil {
+		return false
+	}
+
+	if err := json.Unmarshal([]byte(actual), &a); err != nil {
+		return false
+	}
+
+	return jsonValueContains(a, e)
+}
+
+func jsonValueContains(actual, expected interface{}) bool {
+	switch expected := expected.(type) {
+	case map[string]interface{}:
+		actual, ok := actual.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		for k, v := range expected {
+			if !jsonValueContains(actual[k], v) {
+				return false
+			}
+		}
+
+		return true
+	case []interface{}:
+		actual, ok := actual.([]interface{})
+		if !ok || len(actual) != len(expected) {
+			return false
+		}
+
+		for i, v := range expected {
+			if !jsonValueContains(actual[i], v) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return actual == expected
+	}
+}
+
 func findFunctionByTwoPartKey(ctx context.Context, conn *cloudfront.Client, name string, stage awstypes.FunctionStage) (*cloudfront.DescribeFunctionOutput, error) {
 	input := &cloudfront.DescribeFunctionInput{
 		Name:  aws.String(name),
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -30,6 +31,54 @@ func testAccErrorCheckSkipFunction(t *testing.T) resource.ErrorCheckFunc {
 	)
 }
 
+func TestFunctionOutputMatches(t *testing.T) {
+	t.Parallel()
+
+	actual := `{"response":{"headers":{"location":{"value":"https://aws.amazon.com/"}},"statusDescription":"Found","cookies":{},"statusCode":302}}`
+
+	testCases := []struct {
+		name     string
+		expected string
+		want     bool
+	}{
+		{
+			name:     "equal",
+			expected: actual,
+			want:     true,
+		},
+		{
+			name:     "subset",
+			expected: `{"response":{"statusCode":302}}`,
+			want:     true,
+		},
+		{
+			name:     "different value",
+			expected: `{"response":{"statusCode":301}}`,
+			want:     false,
+		},
+		{
+			name:     "missing key",
+			expected: `{"request":{}}`,
+			want:     false,
+		},
+		{
+			name:     "invalid JSON",
+			expected: `{`,
+			want:     false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfcloudfront.FunctionOutputMatches(testCase.expected, actual); got != testCase.want {
+				t.Errorf("FunctionOutputMatches() = %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestAccCloudFrontFunction_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf cloudfront.DescribeFunctionOutput
@@ -60,7 +109,7 @@ func TestAccCloudFrontFunction_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"publish"},
+				ImportStateVerifyIgnore: []string{"block_publish_on_test_failure", "publish"},
 			},
 		},
 	})
@@ -117,7 +166,7 @@ func TestAccCloudFrontFunction_publish(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"publish"},
+				ImportStateVerifyIgnore: []string{"block_publish_on_test_failure", "publish"},
 			},
 			{
 				Config: testAccFunctionConfig_publish(rName, true),
@@ -167,7 +216,7 @@ func TestAccCloudFrontFunction_associated(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"publish"},
+				ImportStateVerifyIgnore: []string{"block_publish_on_test_failure", "publish"},
 			},
 			{
 				Config: testAccFunctionConfig_unassociated(rName),
@@ -209,7 +258,7 @@ func TestAccCloudFrontFunction_Update_code(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"publish"},
+				ImportStateVerifyIgnore: []string{"block_publish_on_test_failure", "publish"},
 			},
 		},
 	})
@@ -249,7 +298,7 @@ func TestAccCloudFrontFunction_UpdateCodeAndPublish(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"publish"},
+				ImportStateVerifyIgnore: []string{"block_publish_on_test_failure", "publish"},
 			},
 		},
 	})
@@ -285,7 +334,7 @@ func TestAccCloudFrontFunction_Update_comment(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"publish"},
+				ImportStateVerifyIgnore: []string{"block_publish_on_test_failure", "publish"},
 			},
 		},
 	})
@@ -314,7 +363,7 @@ func TestAccCloudFrontFunction_KeyValueStoreAssociations(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"publish"},
+				ImportStateVerifyIgnore: []string{"block_publish_on_test_failure", "publish"},
 			},
 			{
 				Config: testAccFunctionConfig_KeyValueStoreAssociationCodeUpdate(rName),
@@ -334,6 +383,70 @@ func TestAccCloudFrontFunction_KeyValueStoreAssociations(t *testing.T) {
 	})
 }
 
+func TestAccCloudFrontFunction_testEvent(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf cloudfront.DescribeFunctionOutput
+	resourceName := "aws_cloudfront_function.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_testEvent(rName, 302, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "test_event.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "test_event.0.name", "redirect"),
+					resource.TestCheckResourceAttrSet(resourceName, "test_event.0.compute_utilization"),
+					resource.TestCheckResourceAttr(resourceName, "test_event.0.function_error_message", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "test_event.0.output"),
+					resource.TestCheckResourceAttr(resourceName, "test_event.0.passed", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "UNASSOCIATED"),
+				),
+			},
+			{
+				Config: testAccFunctionConfig_testEvent(rName, 301, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "test_event.0.passed", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontFunction_testEventBlockPublish(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf cloudfront.DescribeFunctionOutput
+	resourceName := "aws_cloudfront_function.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_testEvent(rName, 302, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "test_event.0.passed", acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(resourceName, "live_stage_etag", resourceName, "etag"),
+				),
+			},
+			{
+				Config:      testAccFunctionConfig_testEvent(rName, 301, true),
+				ExpectError: regexache.MustCompile(`test events failed, not publishing: redirect`),
+			},
+		},
+	})
+}
+
 func testAccCheckFunctionDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontClient(ctx)
@@ -697,3 +810,54 @@ EOT
 }
 `, rName))
 }
+
+func testAccFunctionConfig_testEvent(rName string, expectedStatusCode int, blockPublish bool) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_function" "test" {
+  name    = %[1]q
+  runtime = "cloudfront-js-2.0"
+  code    = <<-EOT
+function handler(event) {
+	return {
+		statusCode: 302,
+		statusDescription: 'Found',
+		headers: {
+			'location': { value: 'https://aws.amazon.com/cloudfront/' }
+		}
+	};
+}
+EOT
+
+  block_publish_on_test_failure = %[3]t
+
+  test_event {
+    name = "redirect"
+    event_object = jsonencode({
+      version = "1.0"
+      context = {
+        eventType = "viewer-request"
+      }
+      viewer = {
+        ip = "198.51.100.11"
+      }
+      request = {
+        method      = "GET"
+        uri         = "/index.html"
+        headers     = {}
+        cookies     = {}
+        querystring = {}
+      }
+    })
+    expected_output = jsonencode({
+      response = {
+        statusCode        = %[2]d
+        statusDescription = "Found"
+        headers = {
+          location = { value = "https://aws.amazon.com/cloudfront/" }
+        }
+      }
+    })
+  }
+}
+`, rName, expectedStatusCode, blockPublish)
+}
@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html#limits-invalidations.
+	invalidationMaxPaths         = 3000
+	invalidationMaxWildcardPaths = 15
+)
+
+// @SDKResource("aws_cloudfront_invalidation", name="Invalidation")
+func resourceInvalidation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInvalidationCreate,
+		ReadWithoutTimeout:   resourceInvalidationRead,
+		UpdateWithoutTimeout: schema.NoopContext, // Allow wait_for_completion update.
+		DeleteWithoutTimeout: resourceInvalidationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"distribution_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"invalidation_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"paths": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexache.MustCompile(`^/`), "must begin with /"),
+				},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceInvalidationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontClient(ctx)
+
+	distributionID := d.Get("distribution_id").(string)
+	callerReference := id.UniqueId()
+	batches := invalidationPathBatches(flex.ExpandStringValueList(d.Get("paths").([]interface{})))
+
+	var invalidationIDs []string
+	for i, paths := range batches {
+		input := &cloudfront.CreateInvalidationInput{
+			DistributionId: aws.String(distributionID),
+			InvalidationBatch: &awstypes.InvalidationBatch{
+				CallerReference: aws.String(fmt.Sprintf("%s-%d", callerReference, i)),
+				Paths: &awstypes.Paths{
+					Items:    paths,
+					Quantity: aws.Int32(int32(len(paths))),
+				},
+			},
+		}
+
+		// Earlier batches count towards the distribution's in-progress invalidation limit.
+		outputRaw, err := tfresource.RetryWhenIsA[*awstypes.TooManyInvalidationsInProgress](ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+			return conn.CreateInvalidation(ctx, input)
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating CloudFront Invalidation (%s): %s", distributionID, err)
+		}
+
+		invalidationIDs = append(invalidationIDs, aws.ToString(outputRaw.(*cloudfront.CreateInvalidationOutput).Invalidation.Id))
+	}
+
+	d.SetId(callerReference)
+	d.Set("invalidation_ids", invalidationIDs)
+
+	if d.Get("wait_for_completion").(bool) {
+		for _, invalidationID := range invalidationIDs {
+			if _, err := waitInvalidationCompleted(ctx, conn, distributionID, invalidationID, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for CloudFront Invalidation (%s) complete: %s", invalidationID, err)
+			}
+		}
+	}
+
+	return append(diags, resourceInvalidationRead(ctx, d, meta)...)
+}
+
+func resourceInvalidationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontClient(ctx)
+
+	distributionID := d.Get("distribution_id").(string)
+	invalidationIDs := flex.ExpandStringValueList(d.Get("invalidation_ids").([]interface{}))
+
+	status := invalidationStatusCompleted
+	var found int
+	for _, invalidationID := range invalidationIDs {
+		output, err := findInvalidationByTwoPartKey(ctx, conn, distributionID, invalidationID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading CloudFront Invalidation (%s): %s", invalidationID, err)
+		}
+
+		found++
+		if v := aws.ToString(output.Status); v != invalidationStatusCompleted {
+			status = v
+		}
+	}
+
+	if !d.IsNewResource() && found == 0 {
+		log.Printf("[WARN] CloudFront Invalidation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	d.Set(names.AttrStatus, status)
+
+	return diags
+}
+
+func resourceInvalidationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	log.Printf("[DEBUG] CloudFront Invalidation (%s) cannot be deleted, removing from state", d.Id())
+
+	return diags
+}
+
+// invalidationPathBatches splits paths into batches that each fit in a single CreateInvalidation call.
+func invalidationPathBatches(paths []string) [][]string {
+	var batches [][]string
+	var batch []string
+	var wildcards int
+
+	for _, path := range paths {
+		wildcard := strings.HasSuffix(path, "*")
+
+		if len(batch) == invalidationMaxPaths || (wildcard && wildcards == invalidationMaxWildcardPaths) {
+			batches = append(batches, batch)
+			batch, wildcards = nil, 0
+		}
+
+		batch = append(batch, path)
+		if wildcard {
+			wildcards++
+		}
+	}
+
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches
+}
+
+func findInvalidationByTwoPartKey(ctx context.Context, conn *cloudfront.Client, distributionID, invalidationID string) (*awstypes.Invalidation, error) {
+	input := &cloudfront.GetInvalidationInput{
+		DistributionId: aws.String(distributionID),
+		Id:             aws.String(invalidationID),
+	}
+
+	output, err := conn.GetInvalidation(ctx, input)
+
+	if errs.IsA[*awstypes.NoSuchDistribution](err) || errs.IsA[*awstypes.NoSuchInvalidation](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Invalidation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Invalidation, nil
+}
+
+func statusInvalidation(ctx context.Context, conn *cloudfront.Client, distributionID, invalidationID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findInvalidationByTwoPartKey(ctx, conn, distributionID, invalidationID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.Status), nil
+	}
+}
+
+func waitInvalidationCompleted(ctx context.Context, conn *cloudfront.Client, distributionID, invalidationID string, timeout time.Duration) (*awstypes.Invalidation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{invalidationStatusInProgress},
+		Target:     []string{invalidationStatusCompleted},
+		Refresh:    statusInvalidation(ctx, conn, distributionID, invalidationID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Invalidation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
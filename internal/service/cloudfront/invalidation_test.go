@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestInvalidationPathBatches(t *testing.T) {
+	t.Parallel()
+
+	paths := func(n int, suffix string) []string {
+		var s []string
+		for i := 0; i < n; i++ {
+			s = append(s, fmt.Sprintf("/%d%s", i, suffix))
+		}
+		return s
+	}
+
+	testCases := []struct {
+		name    string
+		paths   []string
+		wantLen []int
+	}{
+		{
+			name:    "single",
+			paths:   []string{"/*"},
+			wantLen: []int{1},
+		},
+		{
+			name:    "max paths",
+			paths:   paths(3000, ""),
+			wantLen: []int{3000},
+		},
+		{
+			name:    "over max paths",
+			paths:   paths(3001, ""),
+			wantLen: []int{3000, 1},
+		},
+		{
+			name:    "over max wildcards",
+			paths:   append(paths(16, "*"), paths(2, "")...),
+			wantLen: []int{15, 3},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfcloudfront.InvalidationPathBatches(testCase.paths)
+
+			if len(got) != len(testCase.wantLen) {
+				t.Fatalf("got %d batches, want %d", len(got), len(testCase.wantLen))
+			}
+
+			for i, v := range got {
+				if len(v) != testCase.wantLen[i] {
+					t.Errorf("batch %d: got %d paths, want %d", i, len(v), testCase.wantLen[i])
+				}
+			}
+		})
+	}
+}
+
+func TestAccCloudFrontInvalidation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Invalidation
+	resourceName := "aws_cloudfront_invalidation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvalidationConfig_basic("v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInvalidationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "distribution_id", "aws_cloudfront_distribution.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "invalidation_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "paths.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "Completed"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "triggers.version", "v1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontInvalidation_triggers(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 awstypes.Invalidation
+	resourceName := "aws_cloudfront_invalidation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvalidationConfig_basic("v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInvalidationExists(ctx, resourceName, &v1),
+				),
+			},
+			{
+				Config: testAccInvalidationConfig_basic("v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInvalidationExists(ctx, resourceName, &v2),
+					testAccCheckInvalidationRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "triggers.version", "v2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInvalidationExists(ctx context.Context, n string, v *awstypes.Invalidation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontClient(ctx)
+
+		output, err := tfcloudfront.FindInvalidationByTwoPartKey(ctx, conn, rs.Primary.Attributes["distribution_id"], rs.Primary.Attributes["invalidation_ids.0"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckInvalidationRecreated(before, after *awstypes.Invalidation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.Id), aws.ToString(after.Id); before == after {
+			return fmt.Errorf("CloudFront Invalidation (%s) not recreated", before)
+		}
+
+		return nil
+	}
+}
+
+func testAccInvalidationConfig_basic(version string) string {
+	return acctest.ConfigCompose(testAccMonitoringSubscriptionConfig_base(), fmt.Sprintf(`
+resource "aws_cloudfront_invalidation" "test" {
+  distribution_id = aws_cloudfront_distribution.test.id
+  paths           = ["/index.html", "/assets/*"]
+
+  triggers = {
+    version = %[1]q
+  }
+}
+`, version))
+}
@@ -119,6 +119,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_cloudfront_function",
 			Name:     "Function",
 		},
+		{
+			Factory:  resourceInvalidation,
+			TypeName: "aws_cloudfront_invalidation",
+			Name:     "Invalidation",
+		},
 		{
 			Factory:  resourceKeyGroup,
 			TypeName: "aws_cloudfront_key_group",
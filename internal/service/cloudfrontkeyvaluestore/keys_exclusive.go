@@ -0,0 +1,560 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfrontkeyvaluestore
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	// https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html#limits-key-value-stores.
+	updateKeysMaxBatchSize = 50
+
+	keysExclusiveConflictTimeout = 2 * time.Minute
+)
+
+// @FrameworkResource("aws_cloudfrontkeyvaluestore_keys_exclusive", name="Keys Exclusive")
+func newKeysExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &keysExclusiveResource{}
+
+	return r, nil
+}
+
+type keysExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (*keysExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cloudfrontkeyvaluestore_keys_exclusive"
+}
+
+func (r *keysExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"key_value_store_arn": schema.StringAttribute{
+				CustomType:          fwtypes.ARNType,
+				Required:            true,
+				MarkdownDescription: "The Amazon Resource Name (ARN) of the Key Value Store.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_batch_size": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(updateKeysMaxBatchSize),
+				MarkdownDescription: "Maximum number of puts and deletes sent in a single UpdateKeys call.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, updateKeysMaxBatchSize),
+				},
+			},
+			"source_file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path to a local JSON file in the CloudFront KeyValueStore import format.",
+			},
+			"source_file_hash": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Hash of the key value pairs read from `source_file`.",
+			},
+			"source_file_keys": schema.SetAttribute{
+				CustomType:          fwtypes.SetOfStringType,
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Keys read from `source_file` when it was last applied.",
+			},
+			"total_size_in_bytes": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Total size of the Key Value Store in bytes.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"resource_key_value_pair": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[resourceKeyValuePairModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The key to put.",
+						},
+						names.AttrValue: schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The value to put.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *keysExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data keysExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	kvsARN := data.KvsARN.ValueString()
+
+	totalSizeInBytes, err := r.syncKeys(ctx, &data)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating CloudFront KeyValueStore Keys Exclusive (%s)", kvsARN), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.TotalSizeInBytes = fwflex.Int64ToFramework(ctx, totalSizeInBytes)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *keysExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data keysExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontKeyValueStoreClient(ctx)
+
+	kvsARN := data.KvsARN.ValueString()
+	kvs, err := findKeyValueStoreByARN(ctx, conn, kvsARN)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront KeyValueStore Keys Exclusive (%s)", kvsARN), err.Error())
+
+		return
+	}
+
+	keys, err := findKeysByARN(ctx, conn, kvsARN)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront KeyValueStore Keys Exclusive (%s)", kvsARN), err.Error())
+
+		return
+	}
+
+	// Keys that come from the source file are tracked by hash rather than individually.
+	// The source file itself is only read when planning and applying, so the keys from the last apply are used.
+	sourceKeys := make(map[string]struct{})
+	for _, v := range fwflex.ExpandFrameworkStringValueSet(ctx, data.SourceFileKeys) {
+		sourceKeys[v] = struct{}{}
+	}
+
+	var pairs []resourceKeyValuePairModel
+	var sourcePairs []keyValuePair
+	for _, v := range keys {
+		key, value := aws.ToString(v.Key), aws.ToString(v.Value)
+
+		if _, ok := sourceKeys[key]; ok {
+			sourcePairs = append(sourcePairs, keyValuePair{Key: key, Value: value})
+			continue
+		}
+
+		pairs = append(pairs, resourceKeyValuePairModel{
+			Key:   types.StringValue(key),
+			Value: types.StringValue(value),
+		})
+	}
+
+	data.ResourceKeyValuePairs = fwtypes.NewSetNestedObjectValueOfValueSliceMust(ctx, pairs)
+	if data.SourceFile.IsNull() {
+		data.SourceFileHash = types.StringNull()
+	} else {
+		data.SourceFileHash = types.StringValue(hashKeyValuePairs(sourcePairs))
+	}
+	data.TotalSizeInBytes = fwflex.Int64ToFramework(ctx, kvs.TotalSizeInBytes)
+	if data.MaxBatchSize.IsNull() {
+		data.MaxBatchSize = types.Int64Value(updateKeysMaxBatchSize)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *keysExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new keysExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.ResourceKeyValuePairs.Equal(old.ResourceKeyValuePairs) || !new.SourceFile.Equal(old.SourceFile) || !new.SourceFileHash.Equal(old.SourceFileHash) {
+		kvsARN := new.KvsARN.ValueString()
+
+		totalSizeInBytes, err := r.syncKeys(ctx, &new)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating CloudFront KeyValueStore Keys Exclusive (%s)", kvsARN), err.Error())
+
+			return
+		}
+
+		// Set values for unknowns.
+		new.TotalSizeInBytes = fwflex.Int64ToFramework(ctx, totalSizeInBytes)
+	} else {
+		new.TotalSizeInBytes = old.TotalSizeInBytes
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *keysExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("key_value_store_arn"), request, response)
+}
+
+func (r *keysExclusiveResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var sourceFile types.String
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("source_file"), &sourceFile)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if sourceFile.IsUnknown() {
+		return
+	}
+
+	var pairs []keyValuePair
+	if !sourceFile.IsNull() {
+		v, err := readKeyValueStoreImportFile(sourceFile.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("source_file"), "reading source file", err.Error())
+
+			return
+		}
+
+		pairs = v
+	}
+
+	hash, keys := sourceFileAttributes(ctx, sourceFile, pairs)
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("source_file_hash"), hash)...)
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("source_file_keys"), keys)...)
+}
+
+// syncKeys makes the key value store's keys match the configured key value pairs and source file.
+//
+// Keys that are configured but missing or have a different value are put and keys that exist but
+// are not configured are deleted. Changes are sent in batches of at most max_batch_size, each batch
+// guarded by the ETag returned from the previous one. If the key value store is modified outside
+// Terraform between batches the batch fails with ConflictException and is retried with a fresh ETag.
+// The total size of the key value store after the changes is returned.
+func (r *keysExclusiveResource) syncKeys(ctx context.Context, data *keysExclusiveResourceModel) (*int64, error) {
+	conn := r.Meta().CloudFrontKeyValueStoreClient(ctx)
+
+	kvsARN := data.KvsARN.ValueString()
+
+	want, sourcePairs, err := data.keyValuePairs(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	data.SourceFileHash, data.SourceFileKeys = sourceFileAttributes(ctx, data.SourceFile, sourcePairs)
+
+	// Updating keys changes the etag of the key value store.
+	// Use a mutex serialize actions
+	mutexKey := kvsARN
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	have, err := findKeysByARN(ctx, conn, kvsARN)
+
+	if err != nil {
+		return nil, fmt.Errorf("listing keys: %w", err)
+	}
+
+	puts, deletes := diffKeyValuePairs(have, want)
+
+	kvs, err := findKeyValueStoreByARN(ctx, conn, kvsARN)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading ETag: %w", err)
+	}
+
+	etag, totalSizeInBytes := kvs.ETag, kvs.TotalSizeInBytes
+
+	for _, input := range updateKeysBatches(kvsARN, puts, deletes, int(data.MaxBatchSize.ValueInt64())) {
+		outputRaw, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, keysExclusiveConflictTimeout, func() (interface{}, error) {
+			if etag == nil {
+				v, err := findETagByARN(ctx, conn, kvsARN)
+
+				if err != nil {
+					return nil, err
+				}
+
+				etag = v
+			}
+
+			input.IfMatch = etag
+
+			output, err := conn.UpdateKeys(ctx, input)
+
+			if errs.IsA[*awstypes.ConflictException](err) {
+				etag = nil
+			}
+
+			return output, err
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("updating keys: %w", err)
+		}
+
+		output := outputRaw.(*cloudfrontkeyvaluestore.UpdateKeysOutput)
+		etag, totalSizeInBytes = output.ETag, output.TotalSizeInBytes
+	}
+
+	return totalSizeInBytes, nil
+}
+
+// diffKeyValuePairs returns the puts and deletes needed to turn have into want.
+func diffKeyValuePairs(have []awstypes.ListKeysResponseListItem, want []keyValuePair) ([]awstypes.PutKeyRequestListItem, []awstypes.DeleteKeyRequestListItem) {
+	haveValues := make(map[string]string, len(have))
+	for _, v := range have {
+		haveValues[aws.ToString(v.Key)] = aws.ToString(v.Value)
+	}
+
+	var puts []awstypes.PutKeyRequestListItem
+	wantKeys := make(map[string]struct{}, len(want))
+	for _, v := range want {
+		wantKeys[v.Key] = struct{}{}
+
+		if value, ok := haveValues[v.Key]; ok && value == v.Value {
+			continue
+		}
+
+		puts = append(puts, awstypes.PutKeyRequestListItem{
+			Key:   aws.String(v.Key),
+			Value: aws.String(v.Value),
+		})
+	}
+
+	var deletes []awstypes.DeleteKeyRequestListItem
+	for _, v := range have {
+		if _, ok := wantKeys[aws.ToString(v.Key)]; ok {
+			continue
+		}
+
+		deletes = append(deletes, awstypes.DeleteKeyRequestListItem{
+			Key: v.Key,
+		})
+	}
+
+	return puts, deletes
+}
+
+// updateKeysBatches splits puts and deletes into UpdateKeys calls of at most batchSize changes each.
+// Deletes are sent first so that a store close to its size limit has room for the puts.
+func updateKeysBatches(kvsARN string, puts []awstypes.PutKeyRequestListItem, deletes []awstypes.DeleteKeyRequestListItem, batchSize int) []*cloudfrontkeyvaluestore.UpdateKeysInput {
+	var inputs []*cloudfrontkeyvaluestore.UpdateKeysInput
+	var input *cloudfrontkeyvaluestore.UpdateKeysInput
+
+	next := func() {
+		if input == nil || len(input.Deletes)+len(input.Puts) == batchSize {
+			input = &cloudfrontkeyvaluestore.UpdateKeysInput{
+				KvsARN: aws.String(kvsARN),
+			}
+			inputs = append(inputs, input)
+		}
+	}
+
+	for _, v := range deletes {
+		next()
+		input.Deletes = append(input.Deletes, v)
+	}
+
+	for _, v := range puts {
+		next()
+		input.Puts = append(input.Puts, v)
+	}
+
+	return inputs
+}
+
+type keyValuePair struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// keyValueStoreImportFile is the format of a CloudFront KeyValueStore import file.
+// See https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/kvs-with-functions-create-s3-kvp.html.
+type keyValueStoreImportFile struct {
+	Data []keyValuePair `json:"data"`
+}
+
+func readKeyValueStoreImportFile(source string) ([]keyValuePair, error) {
+	path, err := homedir.Expand(source)
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source file (%s): %w", source, err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading source file (%s): %w", path, err)
+	}
+
+	var file keyValueStoreImportFile
+	if err := json.Unmarshal(b, &file); err != nil {
+		return nil, fmt.Errorf("parsing source file (%s): %w", path, err)
+	}
+
+	return file.Data, nil
+}
+
+// hashKeyValuePairs returns a hash of the key value pairs that is independent of their order.
+func hashKeyValuePairs(pairs []keyValuePair) string {
+	pairs = slices.Clone(pairs)
+	slices.SortFunc(pairs, func(a, b keyValuePair) int {
+		return cmp.Compare(a.Key, b.Key)
+	})
+
+	b, _ := json.Marshal(pairs)
+
+	return fmt.Sprintf("%x", sha256.Sum256(b))
+}
+
+func findKeysByARN(ctx context.Context, conn *cloudfrontkeyvaluestore.Client, arn string) ([]awstypes.ListKeysResponseListItem, error) {
+	input := &cloudfrontkeyvaluestore.ListKeysInput{
+		KvsARN:     aws.String(arn),
+		MaxResults: aws.Int32(50),
+	}
+	var output []awstypes.ListKeysResponseListItem
+
+	pages := cloudfrontkeyvaluestore.NewListKeysPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+type keysExclusiveResourceModel struct {
+	KvsARN                fwtypes.ARN                                               `tfsdk:"key_value_store_arn"`
+	MaxBatchSize          types.Int64                                               `tfsdk:"max_batch_size"`
+	ResourceKeyValuePairs fwtypes.SetNestedObjectValueOf[resourceKeyValuePairModel] `tfsdk:"resource_key_value_pair"`
+	SourceFile            types.String                                              `tfsdk:"source_file"`
+	SourceFileHash        types.String                                              `tfsdk:"source_file_hash"`
+	SourceFileKeys        fwtypes.SetValueOf[types.String]                          `tfsdk:"source_file_keys"`
+	TotalSizeInBytes      types.Int64                                               `tfsdk:"total_size_in_bytes"`
+}
+
+type resourceKeyValuePairModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+// keyValuePairs returns the desired key value pairs and those of them that were read from the source file.
+// A key that appears both in the source file and in a resource_key_value_pair block is an error.
+func (data *keysExclusiveResourceModel) keyValuePairs(ctx context.Context) ([]keyValuePair, []keyValuePair, error) {
+	var sourcePairs []keyValuePair
+	sourceKeys := make(map[string]struct{})
+
+	if !data.SourceFile.IsNull() {
+		v, err := readKeyValueStoreImportFile(data.SourceFile.ValueString())
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		sourcePairs = v
+		for _, v := range sourcePairs {
+			sourceKeys[v.Key] = struct{}{}
+		}
+	}
+
+	tfList, diags := data.ResourceKeyValuePairs.ToSlice(ctx)
+	if diags.HasError() {
+		return nil, nil, fwdiag.DiagnosticsError(diags)
+	}
+
+	pairs := slices.Clone(sourcePairs)
+	for _, v := range tfList {
+		key := v.Key.ValueString()
+
+		if _, ok := sourceKeys[key]; ok {
+			return nil, nil, fmt.Errorf("key (%s) is set in both source_file and resource_key_value_pair", key)
+		}
+
+		pairs = append(pairs, keyValuePair{Key: key, Value: v.Value.ValueString()})
+	}
+
+	return pairs, sourcePairs, nil
+}
+
+// sourceFileAttributes returns the source_file_hash and source_file_keys values for the key value pairs read from a source file.
+func sourceFileAttributes(ctx context.Context, sourceFile types.String, pairs []keyValuePair) (types.String, fwtypes.SetValueOf[types.String]) {
+	if sourceFile.IsNull() {
+		return types.StringNull(), fwtypes.NewSetValueOfNull[types.String](ctx)
+	}
+
+	keys := tfslices.ApplyToAll(pairs, func(v keyValuePair) attr.Value {
+		return types.StringValue(v.Key)
+	})
+
+	return types.StringValue(hashKeyValuePairs(pairs)), fwtypes.NewSetValueOfMust[types.String](ctx, keys)
+}
@@ -0,0 +1,344 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfrontkeyvaluestore_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudfrontkeyvaluestore "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfrontkeyvaluestore"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestUpdateKeysBatches(t *testing.T) {
+	t.Parallel()
+
+	puts := func(n int) []awstypes.PutKeyRequestListItem {
+		var s []awstypes.PutKeyRequestListItem
+		for i := 0; i < n; i++ {
+			s = append(s, awstypes.PutKeyRequestListItem{Key: aws.String(fmt.Sprintf("put%d", i)), Value: aws.String("v")})
+		}
+		return s
+	}
+	deletes := func(n int) []awstypes.DeleteKeyRequestListItem {
+		var s []awstypes.DeleteKeyRequestListItem
+		for i := 0; i < n; i++ {
+			s = append(s, awstypes.DeleteKeyRequestListItem{Key: aws.String(fmt.Sprintf("delete%d", i))})
+		}
+		return s
+	}
+
+	testCases := []struct {
+		name        string
+		puts        int
+		deletes     int
+		batchSize   int
+		wantPuts    []int
+		wantDeletes []int
+	}{
+		{
+			name:      "empty",
+			batchSize: 50,
+		},
+		{
+			name:        "single batch",
+			puts:        10,
+			deletes:     5,
+			batchSize:   50,
+			wantPuts:    []int{10},
+			wantDeletes: []int{5},
+		},
+		{
+			name:        "deletes first",
+			puts:        3,
+			deletes:     3,
+			batchSize:   2,
+			wantPuts:    []int{0, 1, 2},
+			wantDeletes: []int{2, 1, 0},
+		},
+		{
+			name:        "many batches",
+			puts:        120,
+			batchSize:   50,
+			wantPuts:    []int{50, 50, 20},
+			wantDeletes: []int{0, 0, 0},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfcloudfrontkeyvaluestore.UpdateKeysBatches("arn", puts(testCase.puts), deletes(testCase.deletes), testCase.batchSize)
+
+			if len(got) != len(testCase.wantPuts) {
+				t.Fatalf("got %d batches, want %d", len(got), len(testCase.wantPuts))
+			}
+
+			for i, v := range got {
+				if len(v.Puts) != testCase.wantPuts[i] {
+					t.Errorf("batch %d: got %d puts, want %d", i, len(v.Puts), testCase.wantPuts[i])
+				}
+				if len(v.Deletes) != testCase.wantDeletes[i] {
+					t.Errorf("batch %d: got %d deletes, want %d", i, len(v.Deletes), testCase.wantDeletes[i])
+				}
+			}
+		})
+	}
+}
+
+func TestAccCloudFrontKeyValueStoreKeysExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfrontkeyvaluestore_keys_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudFront)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFront),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeysExclusiveConfig_basic(rName, "v1", "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeysExclusiveCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttrPair(resourceName, "key_value_store_arn", "aws_cloudfront_key_value_store.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "max_batch_size", "50"),
+					resource.TestCheckResourceAttr(resourceName, "resource_key_value_pair.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_key_value_pair.*", map[string]string{
+						names.AttrKey:   "key1",
+						names.AttrValue: "v1",
+					}),
+					resource.TestCheckResourceAttrSet(resourceName, "total_size_in_bytes"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccKeysExclusiveImportStateIDFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "key_value_store_arn",
+			},
+			{
+				Config: testAccKeysExclusiveConfig_basic(rName, "v1", "v3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeysExclusiveCount(ctx, resourceName, 2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_key_value_pair.*", map[string]string{
+						names.AttrKey:   "key2",
+						names.AttrValue: "v3",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontKeyValueStoreKeysExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfrontkeyvaluestore_keys_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudFront)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFront),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeysExclusiveConfig_basic(rName, "v1", "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeysExclusiveCount(ctx, resourceName, 2),
+					testAccCheckKeysExclusivePutKey(ctx, resourceName, "key3", "v3"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccKeysExclusiveConfig_basic(rName, "v1", "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeysExclusiveCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "source_file_keys.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "source_file_keys.*", "file0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontKeyValueStoreKeysExclusive_sourceFile(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfrontkeyvaluestore_keys_exclusive.test"
+	sourceFile := filepath.Join(t.TempDir(), "data.json")
+
+	var data string
+	for i := 0; i < 120; i++ {
+		if i > 0 {
+			data += ","
+		}
+		data += fmt.Sprintf(`{"key":"file%[1]d","value":"value%[1]d"}`, i)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudFront)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFront),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccWriteKeysExclusiveSourceFile(t, sourceFile, `{"data":[`+data+`]}`)
+				},
+				Config: testAccKeysExclusiveConfig_sourceFile(rName, sourceFile),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeysExclusiveCount(ctx, resourceName, 121),
+					resource.TestCheckResourceAttr(resourceName, "resource_key_value_pair.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "source_file_hash"),
+					resource.TestCheckResourceAttr(resourceName, "source_file_keys.#", "120"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccWriteKeysExclusiveSourceFile(t, sourceFile, `{"data":[{"key":"file0","value":"updated"}]}`)
+				},
+				Config: testAccKeysExclusiveConfig_sourceFile(rName, sourceFile),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeysExclusiveCount(ctx, resourceName, 2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeysExclusiveCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontKeyValueStoreClient(ctx)
+
+		output, err := tfcloudfrontkeyvaluestore.FindKeysByARN(ctx, conn, rs.Primary.Attributes["key_value_store_arn"])
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("CloudFront KeyValueStore (%s) has %d keys, want %d", rs.Primary.Attributes["key_value_store_arn"], got, want)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckKeysExclusivePutKey adds a key outside of Terraform.
+func testAccCheckKeysExclusivePutKey(ctx context.Context, n, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontKeyValueStoreClient(ctx)
+
+		kvsARN := rs.Primary.Attributes["key_value_store_arn"]
+		output, err := conn.DescribeKeyValueStore(ctx, &cloudfrontkeyvaluestore.DescribeKeyValueStoreInput{
+			KvsARN: aws.String(kvsARN),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.PutKey(ctx, &cloudfrontkeyvaluestore.PutKeyInput{
+			IfMatch: output.ETag,
+			Key:     aws.String(key),
+			KvsARN:  aws.String(kvsARN),
+			Value:   aws.String(value),
+		})
+
+		return err
+	}
+}
+
+func testAccKeysExclusiveImportStateIDFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.Attributes["key_value_store_arn"], nil
+	}
+}
+
+func testAccWriteKeysExclusiveSourceFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccKeysExclusiveConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_key_value_store" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccKeysExclusiveConfig_basic(rName, value1, value2 string) string {
+	return acctest.ConfigCompose(testAccKeysExclusiveConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudfrontkeyvaluestore_keys_exclusive" "test" {
+  key_value_store_arn = aws_cloudfront_key_value_store.test.arn
+
+  resource_key_value_pair {
+    key   = "key1"
+    value = %[1]q
+  }
+
+  resource_key_value_pair {
+    key   = "key2"
+    value = %[2]q
+  }
+}
+`, value1, value2))
+}
+
+func testAccKeysExclusiveConfig_sourceFile(rName, sourceFile string) string {
+	return acctest.ConfigCompose(testAccKeysExclusiveConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudfrontkeyvaluestore_keys_exclusive" "test" {
+  key_value_store_arn = aws_cloudfront_key_value_store.test.arn
+  max_batch_size      = 25
+  source_file         = %[1]q
+
+  resource_key_value_pair {
+    key   = "inline"
+    value = "value"
+  }
+}
+`, sourceFile))
+}
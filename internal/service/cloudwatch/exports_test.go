@@ -8,10 +8,15 @@ var (
 	ResourceCompositeAlarm = resourceCompositeAlarm
 	ResourceDashboard      = resourceDashboard
 	ResourceMetricAlarm    = resourceMetricAlarm
+	ResourceMetricAlarms   = resourceMetricAlarms
 	ResourceMetricStream   = resourceMetricStream
 
 	FindCompositeAlarmByName = findCompositeAlarmByName
 	FindDashboardByName      = findDashboardByName
 	FindMetricAlarmByName    = findMetricAlarmByName
+	FindMetricAlarmsByNames  = findMetricAlarmsByNames
 	FindMetricStreamByName   = findMetricStreamByName
+
+	ExpandMetricAlarmsConfigs      = expandMetricAlarmsConfigs
+	MetricAlarmsThresholdOverrides = metricAlarmsThresholdOverrides
 )
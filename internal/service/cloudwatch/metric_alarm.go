@@ -292,31 +292,42 @@ func resourceMetricAlarm() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			verify.SetTagsDiff,
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				_, metricNameOk := diff.GetOk(names.AttrMetricName)
-				_, statisticOk := diff.GetOk("statistic")
-				_, extendedStatisticOk := diff.GetOk("extended_statistic")
+				return validateMetricAlarmConfig(diff)
+			},
+		),
+	}
+}
 
-				if metricNameOk && ((!statisticOk && !extendedStatisticOk) || (statisticOk && extendedStatisticOk)) {
-					return errors.New("One of `statistic` or `extended_statistic` must be set for a cloudwatch metric alarm")
-				}
+// metricAlarmConfig is the subset of *schema.ResourceData used to build a metric alarm.
+// It allows alarms to be expanded from sources other than a resource's top-level schema.
+type metricAlarmConfig interface {
+	Get(string) interface{}
+	GetOk(string) (interface{}, bool)
+}
 
-				if v := diff.Get("metric_query"); v != nil {
-					for _, v := range v.(*schema.Set).List() {
-						tfMap := v.(map[string]interface{})
-						if v, ok := tfMap[names.AttrExpression]; ok && v.(string) != "" {
-							if v := tfMap["metric"]; v != nil {
-								if len(v.([]interface{})) > 0 {
-									return errors.New("No metric_query may have both `expression` and a `metric` specified")
-								}
-							}
-						}
+func validateMetricAlarmConfig(d metricAlarmConfig) error {
+	_, metricNameOk := d.GetOk(names.AttrMetricName)
+	_, statisticOk := d.GetOk("statistic")
+	_, extendedStatisticOk := d.GetOk("extended_statistic")
+
+	if metricNameOk && ((!statisticOk && !extendedStatisticOk) || (statisticOk && extendedStatisticOk)) {
+		return errors.New("One of `statistic` or `extended_statistic` must be set for a cloudwatch metric alarm")
+	}
+
+	if v := d.Get("metric_query"); v != nil {
+		for _, v := range v.(*schema.Set).List() {
+			tfMap := v.(map[string]interface{})
+			if v, ok := tfMap[names.AttrExpression]; ok && v.(string) != "" {
+				if v := tfMap["metric"]; v != nil {
+					if len(v.([]interface{})) > 0 {
+						return errors.New("No metric_query may have both `expression` and a `metric` specified")
 					}
 				}
-
-				return nil
-			},
-		),
+			}
+		}
 	}
+
+	return nil
 }
 
 func resourceMetricAlarmCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -380,38 +391,15 @@ func resourceMetricAlarmRead(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Metric Alarm (%s): %s", d.Id(), err)
 	}
 
-	d.Set("actions_enabled", alarm.ActionsEnabled)
-	d.Set("alarm_actions", alarm.AlarmActions)
-	d.Set("alarm_description", alarm.AlarmDescription)
-	d.Set("alarm_name", alarm.AlarmName)
-	d.Set(names.AttrARN, alarm.AlarmArn)
-	d.Set("comparison_operator", alarm.ComparisonOperator)
-	d.Set("datapoints_to_alarm", alarm.DatapointsToAlarm)
-	if err := d.Set("dimensions", flattenMetricAlarmDimensions(alarm.Dimensions)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting dimensions: %s", err)
-	}
-	d.Set("evaluate_low_sample_count_percentiles", alarm.EvaluateLowSampleCountPercentile)
-	d.Set("evaluation_periods", alarm.EvaluationPeriods)
-	d.Set("extended_statistic", alarm.ExtendedStatistic)
-	d.Set("insufficient_data_actions", alarm.InsufficientDataActions)
-	d.Set(names.AttrMetricName, alarm.MetricName)
-	if len(alarm.Metrics) > 0 {
-		if err := d.Set("metric_query", flattenMetricAlarmMetrics(alarm.Metrics)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting metric_query: %s", err)
+	for k, v := range flattenMetricAlarm(alarm) {
+		if k == "metric_query" && len(alarm.Metrics) == 0 {
+			continue
+		}
+
+		if err := d.Set(k, v); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting %s: %s", k, err)
 		}
 	}
-	d.Set(names.AttrNamespace, alarm.Namespace)
-	d.Set("ok_actions", alarm.OKActions)
-	d.Set("period", alarm.Period)
-	d.Set("statistic", alarm.Statistic)
-	d.Set("threshold", alarm.Threshold)
-	d.Set("threshold_metric_id", alarm.ThresholdMetricId)
-	if alarm.TreatMissingData != nil { // nosemgrep: ci.helper-schema-ResourceData-Set-extraneous-nil-check
-		d.Set("treat_missing_data", alarm.TreatMissingData)
-	} else {
-		d.Set("treat_missing_data", missingDataMissing)
-	}
-	d.Set(names.AttrUnit, alarm.Unit)
 
 	return diags
 }
@@ -472,7 +460,58 @@ func findMetricAlarmByName(ctx context.Context, conn *cloudwatch.Client, name st
 	return tfresource.AssertSingleValueResult(output.MetricAlarms)
 }
 
-func expandPutMetricAlarmInput(ctx context.Context, d *schema.ResourceData) *cloudwatch.PutMetricAlarmInput {
+func findMetricAlarms(ctx context.Context, conn *cloudwatch.Client, input *cloudwatch.DescribeAlarmsInput) ([]types.MetricAlarm, error) {
+	var output []types.MetricAlarm
+
+	pages := cloudwatch.NewDescribeAlarmsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.MetricAlarms...)
+	}
+
+	return output, nil
+}
+
+// metricAlarmMap is a metricAlarmConfig backed by a flattened configuration map.
+// GetOk follows schema.ResourceData semantics and reports zero values as not set.
+type metricAlarmMap map[string]interface{}
+
+func (m metricAlarmMap) Get(key string) interface{} {
+	return m[key]
+}
+
+func (m metricAlarmMap) GetOk(key string) (interface{}, bool) {
+	v, ok := m[key]
+	if !ok || v == nil {
+		return v, false
+	}
+
+	switch v := v.(type) {
+	case string:
+		return v, v != ""
+	case int:
+		return v, v != 0
+	case float64:
+		return v, v != 0
+	case bool:
+		return v, v
+	case map[string]interface{}:
+		return v, len(v) > 0
+	case []interface{}:
+		return v, len(v) > 0
+	case *schema.Set:
+		return v, v.Len() > 0
+	}
+
+	return v, true
+}
+
+func expandPutMetricAlarmInput(ctx context.Context, d metricAlarmConfig) *cloudwatch.PutMetricAlarmInput {
 	apiObject := &cloudwatch.PutMetricAlarmInput{
 		AlarmName:          aws.String(d.Get("alarm_name").(string)),
 		ComparisonOperator: types.ComparisonOperator(d.Get("comparison_operator").(string)),
@@ -550,6 +589,43 @@ func expandPutMetricAlarmInput(ctx context.Context, d *schema.ResourceData) *clo
 	return apiObject
 }
 
+func flattenMetricAlarm(apiObject *types.MetricAlarm) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"actions_enabled":                       aws.ToBool(apiObject.ActionsEnabled),
+		"alarm_actions":                         flex.FlattenStringValueList(apiObject.AlarmActions),
+		"alarm_description":                     aws.ToString(apiObject.AlarmDescription),
+		"alarm_name":                            aws.ToString(apiObject.AlarmName),
+		names.AttrARN:                           aws.ToString(apiObject.AlarmArn),
+		"comparison_operator":                   string(apiObject.ComparisonOperator),
+		"datapoints_to_alarm":                   int(aws.ToInt32(apiObject.DatapointsToAlarm)),
+		"dimensions":                            flattenMetricAlarmDimensions(apiObject.Dimensions),
+		"evaluate_low_sample_count_percentiles": aws.ToString(apiObject.EvaluateLowSampleCountPercentile),
+		"evaluation_periods":                    int(aws.ToInt32(apiObject.EvaluationPeriods)),
+		"extended_statistic":                    aws.ToString(apiObject.ExtendedStatistic),
+		"insufficient_data_actions":             flex.FlattenStringValueList(apiObject.InsufficientDataActions),
+		names.AttrMetricName:                    aws.ToString(apiObject.MetricName),
+		"metric_query":                          flattenMetricAlarmMetrics(apiObject.Metrics),
+		names.AttrNamespace:                     aws.ToString(apiObject.Namespace),
+		"ok_actions":                            flex.FlattenStringValueList(apiObject.OKActions),
+		"period":                                int(aws.ToInt32(apiObject.Period)),
+		"statistic":                             string(apiObject.Statistic),
+		"threshold":                             aws.ToFloat64(apiObject.Threshold),
+		"threshold_metric_id":                   aws.ToString(apiObject.ThresholdMetricId),
+		"treat_missing_data":                    missingDataMissing,
+		names.AttrUnit:                          string(apiObject.Unit),
+	}
+
+	if v := apiObject.TreatMissingData; v != nil {
+		tfMap["treat_missing_data"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
 func flattenMetricAlarmDimensions(apiObjects []types.Dimension) map[string]interface{} {
 	tfMap := map[string]interface{}{}
 
@@ -0,0 +1,562 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// DescribeAlarms and DeleteAlarms accept at most 100 alarm names per call.
+	metricAlarmsBatchSize = 100
+)
+
+// @SDKResource("aws_cloudwatch_metric_alarms", name="Metric Alarms")
+// @Tags
+func resourceMetricAlarms() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMetricAlarmsCreate,
+		ReadWithoutTimeout:   resourceMetricAlarmsRead,
+		UpdateWithoutTimeout: resourceMetricAlarmsUpdate,
+		DeleteWithoutTimeout: resourceMetricAlarmsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"alarm": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm_description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1024),
+						},
+						"alarm_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"dimensions": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"metric_query": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     resourceMetricAlarm().Schema["metric_query"].Elem,
+						},
+						"threshold": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"arns": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"template": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: metricAlarmsTemplateSchema(),
+				},
+			},
+		},
+
+		CustomizeDiff: customdiff.All(
+			verify.SetTagsDiff,
+			customdiff.ComputedIf("arns", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("alarm")
+			}),
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				alarms, err := expandMetricAlarmsConfigs(diff, metricAlarmsThresholdOverrides(diff.GetRawConfig()))
+
+				if err != nil {
+					return err
+				}
+
+				for _, alarm := range alarms {
+					if err := validateMetricAlarmsConfig(alarm); err != nil {
+						return fmt.Errorf("alarm (%s): %w", alarm.Get("alarm_name").(string), err)
+					}
+				}
+
+				return nil
+			},
+		),
+	}
+}
+
+// metricAlarmsTemplateSchema returns the aws_cloudwatch_metric_alarm schema without the per-alarm identity and tagging attributes.
+func metricAlarmsTemplateSchema() map[string]*schema.Schema {
+	s := resourceMetricAlarm().Schema
+
+	for _, k := range []string{"alarm_name", names.AttrARN, names.AttrTags, names.AttrTagsAll} {
+		delete(s, k)
+	}
+
+	// ConflictsWith addresses top-level attributes, so it can't be used inside the template block.
+	// Conflicts are checked per merged alarm in validateMetricAlarmsConfig instead.
+	for _, v := range s {
+		v.ConflictsWith = nil
+	}
+
+	return s
+}
+
+func validateMetricAlarmsConfig(d metricAlarmConfig) error {
+	if _, ok := d.GetOk("metric_query"); ok {
+		for _, k := range []string{"dimensions", "extended_statistic", names.AttrMetricName, names.AttrNamespace, "period", "statistic"} {
+			if _, ok := d.GetOk(k); ok {
+				return fmt.Errorf("`%s` cannot be specified with `metric_query`", k)
+			}
+		}
+	}
+
+	if _, ok := d.GetOk("threshold_metric_id"); ok {
+		if _, ok := d.GetOk("threshold"); ok {
+			return fmt.Errorf("`threshold` cannot be specified with `threshold_metric_id`")
+		}
+	}
+
+	return validateMetricAlarmConfig(d)
+}
+
+func resourceMetricAlarmsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	alarms, err := expandMetricAlarmsConfigs(d, metricAlarmsThresholdOverrides(d.GetRawConfig()))
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	for _, alarm := range alarms {
+		name := alarm.Get("alarm_name").(string)
+		input := expandPutMetricAlarmInput(ctx, alarm)
+
+		if _, err := conn.PutMetricAlarm(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating CloudWatch Metric Alarm (%s): %s", name, err)
+		}
+	}
+
+	d.SetId(sdkid.UniqueId())
+
+	return append(diags, resourceMetricAlarmsRead(ctx, d, meta)...)
+}
+
+func resourceMetricAlarmsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	tfSet := d.Get("alarm").(*schema.Set)
+	var alarmNames []string
+	for _, tfMapRaw := range tfSet.List() {
+		alarmNames = append(alarmNames, tfMapRaw.(map[string]interface{})["alarm_name"].(string))
+	}
+
+	alarms, err := findMetricAlarmsByNames(ctx, conn, alarmNames)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Metric Alarms (%s): %s", d.Id(), err)
+	}
+
+	if !d.IsNewResource() && len(alarms) == 0 {
+		log.Printf("[WARN] CloudWatch Metric Alarms %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	apiObjects := make(map[string]types.MetricAlarm, len(alarms))
+	for _, alarm := range alarms {
+		apiObjects[aws.ToString(alarm.AlarmName)] = alarm
+	}
+
+	var template map[string]interface{}
+	if v, ok := d.Get("template").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		template = v[0].(map[string]interface{})
+	}
+
+	arns := make(map[string]interface{}, len(alarms))
+	var tfList []interface{}
+	slices.Sort(alarmNames)
+	for _, tfMapRaw := range tfSet.List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		name := tfMap["alarm_name"].(string)
+
+		apiObject, ok := apiObjects[name]
+		if !ok {
+			// Dropping the alarm from state causes it to be recreated on the next apply.
+			log.Printf("[WARN] CloudWatch Metric Alarm %s not found, removing from CloudWatch Metric Alarms (%s)", name, d.Id())
+			continue
+		}
+
+		arns[name] = aws.ToString(apiObject.AlarmArn)
+		observed := flattenMetricAlarm(&apiObject)
+
+		if v, ok := tfMap["alarm_description"].(string); ok && v != "" {
+			tfMap["alarm_description"] = observed["alarm_description"]
+		}
+		// An alarm that inherits the template's threshold is stored as 0, so a threshold that differs from the
+		// template's is either an override (possibly 0) or drift, and is refreshed.
+		if v, ok := tfMap["threshold"].(float64); ok && (v != 0 || observed["threshold"] != template["threshold"]) {
+			tfMap["threshold"] = observed["threshold"]
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	// Report drift from the shared template using the first alarm (by name) that differs from it.
+	if template != nil {
+		for _, name := range alarmNames {
+			apiObject, ok := apiObjects[name]
+			if !ok {
+				continue
+			}
+
+			if drifted := metricAlarmsTemplateDrift(template, flattenMetricAlarm(&apiObject)); drifted {
+				log.Printf("[DEBUG] CloudWatch Metric Alarm %s differs from CloudWatch Metric Alarms (%s) template", name, d.Id())
+				break
+			}
+		}
+	}
+
+	if err := d.Set("alarm", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting alarm: %s", err)
+	}
+	if err := d.Set("arns", arns); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting arns: %s", err)
+	}
+	if template != nil {
+		if err := d.Set("template", []interface{}{template}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting template: %s", err)
+		}
+	}
+
+	// All alarms are tagged identically, so read the tags from any one of them.
+	for _, name := range alarmNames {
+		if arn, ok := arns[name]; ok {
+			tags, err := listTags(ctx, conn, arn.(string))
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "listing tags for CloudWatch Metric Alarm (%s): %s", name, err)
+			}
+
+			setTagsOut(ctx, Tags(tags))
+			break
+		}
+	}
+
+	return diags
+}
+
+func resourceMetricAlarmsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	o, n := d.GetChange("alarm")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+
+	newNames := make(map[string]struct{}, ns.Len())
+	for _, tfMapRaw := range ns.List() {
+		newNames[tfMapRaw.(map[string]interface{})["alarm_name"].(string)] = struct{}{}
+	}
+
+	var del []string
+	for _, tfMapRaw := range os.List() {
+		if name := tfMapRaw.(map[string]interface{})["alarm_name"].(string); name != "" {
+			if _, ok := newNames[name]; !ok {
+				del = append(del, name)
+			}
+		}
+	}
+
+	if err := deleteMetricAlarms(ctx, conn, del); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudWatch Metric Alarms (%s): %s", d.Id(), err)
+	}
+
+	alarms, err := expandMetricAlarmsConfigs(d, metricAlarmsThresholdOverrides(d.GetRawConfig()))
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// A template change applies to every alarm; otherwise only alarms whose own configuration changed are updated.
+	putAll := d.HasChange("template")
+	put := make(map[string]struct{})
+	for _, tfMapRaw := range ns.Difference(os).List() {
+		put[tfMapRaw.(map[string]interface{})["alarm_name"].(string)] = struct{}{}
+	}
+
+	for _, alarm := range alarms {
+		name := alarm.Get("alarm_name").(string)
+
+		if _, ok := put[name]; !ok && !putAll {
+			continue
+		}
+
+		input := expandPutMetricAlarmInput(ctx, alarm)
+
+		if _, err := conn.PutMetricAlarm(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudWatch Metric Alarm (%s): %s", name, err)
+		}
+	}
+
+	// Tags passed to PutMetricAlarm are ignored for existing alarms.
+	if d.HasChange(names.AttrTagsAll) {
+		o, n := d.GetChange(names.AttrTagsAll)
+
+		apiObjects, err := findMetricAlarmsByNames(ctx, conn, tfslices.ApplyToAll(alarms, func(v metricAlarmMap) string {
+			return v.Get("alarm_name").(string)
+		}))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading CloudWatch Metric Alarms (%s): %s", d.Id(), err)
+		}
+
+		for _, apiObject := range apiObjects {
+			if err := updateTags(ctx, conn, aws.ToString(apiObject.AlarmArn), o, n); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating CloudWatch Metric Alarm (%s) tags: %s", aws.ToString(apiObject.AlarmName), err)
+			}
+		}
+	}
+
+	return append(diags, resourceMetricAlarmsRead(ctx, d, meta)...)
+}
+
+func resourceMetricAlarmsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	var alarmNames []string
+	for _, tfMapRaw := range d.Get("alarm").(*schema.Set).List() {
+		alarmNames = append(alarmNames, tfMapRaw.(map[string]interface{})["alarm_name"].(string))
+	}
+
+	log.Printf("[INFO] Deleting CloudWatch Metric Alarms: %s", d.Id())
+	if err := deleteMetricAlarms(ctx, conn, alarmNames); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudWatch Metric Alarms (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func deleteMetricAlarms(ctx context.Context, conn *cloudwatch.Client, alarmNames []string) error {
+	for _, chunk := range tfslices.Chunks(alarmNames, metricAlarmsBatchSize) {
+		_, err := conn.DeleteAlarms(ctx, &cloudwatch.DeleteAlarmsInput{
+			AlarmNames: chunk,
+		})
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func findMetricAlarmsByNames(ctx context.Context, conn *cloudwatch.Client, alarmNames []string) ([]types.MetricAlarm, error) {
+	var output []types.MetricAlarm
+
+	for _, chunk := range tfslices.Chunks(alarmNames, metricAlarmsBatchSize) {
+		input := &cloudwatch.DescribeAlarmsInput{
+			AlarmNames: chunk,
+			AlarmTypes: []types.AlarmType{types.AlarmTypeMetricAlarm},
+		}
+
+		alarms, err := findMetricAlarms(ctx, conn, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, alarms...)
+	}
+
+	return output, nil
+}
+
+// metricAlarmsThresholdOverrides returns the names of the configured alarms that set their own threshold.
+// An alarm's threshold of 0 can't be told apart from an unset one in the alarm set, so the raw configuration is used.
+func metricAlarmsThresholdOverrides(config cty.Value) map[string]bool {
+	overrides := make(map[string]bool)
+
+	if !config.IsKnown() || config.IsNull() {
+		return overrides
+	}
+
+	alarms := config.GetAttr("alarm")
+
+	if !alarms.IsKnown() || alarms.IsNull() {
+		return overrides
+	}
+
+	for it := alarms.ElementIterator(); it.Next(); {
+		_, alarm := it.Element()
+
+		if !alarm.IsKnown() || alarm.IsNull() {
+			continue
+		}
+
+		if name, threshold := alarm.GetAttr("alarm_name"), alarm.GetAttr("threshold"); name.IsKnown() && !name.IsNull() && !threshold.IsNull() {
+			overrides[name.AsString()] = true
+		}
+	}
+
+	return overrides
+}
+
+// expandMetricAlarmsConfigs merges each configured alarm over the shared template.
+// thresholdOverrides holds the names of the alarms whose threshold, even if 0, overrides the template's.
+func expandMetricAlarmsConfigs(d metricAlarmConfig, thresholdOverrides map[string]bool) ([]metricAlarmMap, error) {
+	var template map[string]interface{}
+	if v, ok := d.Get("template").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		template = v[0].(map[string]interface{})
+	}
+
+	tfSet, ok := d.Get("alarm").(*schema.Set)
+	if !ok {
+		return nil, nil
+	}
+
+	var alarms []metricAlarmMap
+	seen := make(map[string]struct{}, tfSet.Len())
+
+	for _, tfMapRaw := range tfSet.List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := tfMap["alarm_name"].(string)
+		if name == "" {
+			// Unknown during plan.
+			continue
+		}
+
+		if _, ok := seen[name]; ok {
+			return nil, fmt.Errorf("duplicate alarm_name (%s)", name)
+		}
+		seen[name] = struct{}{}
+
+		alarms = append(alarms, expandMetricAlarmsConfig(template, tfMap, thresholdOverrides[name]))
+	}
+
+	return alarms, nil
+}
+
+func expandMetricAlarmsConfig(template, tfMap map[string]interface{}, thresholdOverride bool) metricAlarmMap {
+	alarm := make(metricAlarmMap, len(template)+1)
+
+	for k, v := range template {
+		alarm[k] = v
+	}
+
+	alarm["alarm_name"] = tfMap["alarm_name"]
+
+	if v, ok := tfMap["alarm_description"].(string); ok && v != "" {
+		alarm["alarm_description"] = v
+	}
+
+	if v, ok := tfMap["dimensions"].(map[string]interface{}); ok && len(v) > 0 {
+		dimensions := make(map[string]interface{})
+
+		if v, ok := template["dimensions"].(map[string]interface{}); ok {
+			for k, v := range v {
+				dimensions[k] = v
+			}
+		}
+
+		for k, v := range v {
+			dimensions[k] = v
+		}
+
+		alarm["dimensions"] = dimensions
+	}
+
+	if v, ok := tfMap["metric_query"].(*schema.Set); ok && v.Len() > 0 {
+		alarm["metric_query"] = v
+	}
+
+	if v, ok := tfMap["threshold"].(float64); ok && (thresholdOverride || v != 0) {
+		alarm["threshold"] = v
+	}
+
+	return alarm
+}
+
+// metricAlarmsTemplateDrift updates template in place with any template-level values that differ in the observed alarm.
+// Attributes that can be overridden per alarm, along with metric_query, are not compared.
+func metricAlarmsTemplateDrift(template, observed map[string]interface{}) bool {
+	var drifted bool
+
+	for _, k := range []string{
+		"actions_enabled",
+		"comparison_operator",
+		"datapoints_to_alarm",
+		"evaluation_periods",
+		"extended_statistic",
+		names.AttrMetricName,
+		names.AttrNamespace,
+		"period",
+		"statistic",
+		"threshold_metric_id",
+		"treat_missing_data",
+		names.AttrUnit,
+	} {
+		if template[k] != observed[k] {
+			template[k] = observed[k]
+			drifted = true
+		}
+	}
+
+	for _, k := range []string{"alarm_actions", "insufficient_data_actions", "ok_actions"} {
+		v, ok := template[k].(*schema.Set)
+		if !ok {
+			continue
+		}
+
+		o := flex.FlattenStringValueSet(flex.ExpandStringValueList(observed[k].([]interface{})))
+		if !v.Equal(o) {
+			template[k] = o
+			drifted = true
+		}
+	}
+
+	return drifted
+}
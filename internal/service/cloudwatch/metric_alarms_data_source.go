@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cloudwatch_metric_alarms", name="Metric Alarms")
+func dataSourceMetricAlarms() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceMetricAlarmsRead,
+
+		Schema: map[string]*schema.Schema{
+			"alarm_name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"metric_alarms": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"alarm_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"comparison_operator": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dimensions": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"evaluation_periods": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						names.AttrMetricName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrNamespace: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"period": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"state_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state_updated_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"statistic": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"threshold": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"state_value": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.StateValue](),
+			},
+		},
+	}
+}
+
+func dataSourceMetricAlarmsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	input := &cloudwatch.DescribeAlarmsInput{
+		AlarmTypes: []types.AlarmType{types.AlarmTypeMetricAlarm},
+	}
+
+	if v, ok := d.GetOk("alarm_name_prefix"); ok {
+		input.AlarmNamePrefix = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("state_value"); ok {
+		input.StateValue = types.StateValue(v.(string))
+	}
+
+	alarms, err := findMetricAlarms(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Metric Alarms: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("metric_alarms", flattenMetricAlarmsDataSource(alarms)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting metric_alarms: %s", err)
+	}
+
+	return diags
+}
+
+func flattenMetricAlarmsDataSource(apiObjects []types.MetricAlarm) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"alarm_description":   aws.ToString(apiObject.AlarmDescription),
+			"alarm_name":          aws.ToString(apiObject.AlarmName),
+			names.AttrARN:         aws.ToString(apiObject.AlarmArn),
+			"comparison_operator": apiObject.ComparisonOperator,
+			"dimensions":          flattenMetricAlarmDimensions(apiObject.Dimensions),
+			"evaluation_periods":  aws.ToInt32(apiObject.EvaluationPeriods),
+			names.AttrMetricName:  aws.ToString(apiObject.MetricName),
+			names.AttrNamespace:   aws.ToString(apiObject.Namespace),
+			"period":              aws.ToInt32(apiObject.Period),
+			"state_reason":        aws.ToString(apiObject.StateReason),
+			"state_value":         apiObject.StateValue,
+			"statistic":           apiObject.Statistic,
+			"threshold":           aws.ToFloat64(apiObject.Threshold),
+		}
+
+		if v := apiObject.StateUpdatedTimestamp; v != nil {
+			tfMap["state_updated_timestamp"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudWatchMetricAlarmsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_metric_alarms.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricAlarmsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metric_alarms.#", acctest.Ct2),
+					resource.TestCheckResourceAttrSet(dataSourceName, "metric_alarms.0.arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_alarms.0.comparison_operator", "GreaterThanOrEqualToThreshold"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_alarms.0.metric_name", "CPUUtilization"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "metric_alarms.0.state_value"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchMetricAlarmsDataSource_stateValue(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_metric_alarms.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricAlarmsDataSourceConfig_stateValue(rName, "ALARM"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metric_alarms.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccMetricAlarmsDataSourceConfig_base(rName string) string {
+	return testAccMetricAlarmsConfig_basic(rName, 80)
+}
+
+func testAccMetricAlarmsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccMetricAlarmsDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_cloudwatch_metric_alarms" "test" {
+  alarm_name_prefix = %[1]q
+
+  depends_on = [aws_cloudwatch_metric_alarms.test]
+}
+`, rName))
+}
+
+func testAccMetricAlarmsDataSourceConfig_stateValue(rName, stateValue string) string {
+	return acctest.ConfigCompose(testAccMetricAlarmsDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_cloudwatch_metric_alarms" "test" {
+  alarm_name_prefix = %[1]q
+  state_value       = %[2]q
+
+  depends_on = [aws_cloudwatch_metric_alarms.test]
+}
+`, rName, stateValue))
+}
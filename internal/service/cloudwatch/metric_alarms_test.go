@@ -0,0 +1,454 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudwatch "github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatch"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestExpandMetricAlarmsConfigs(t *testing.T) {
+	t.Parallel()
+
+	d := schema.TestResourceDataRaw(t, tfcloudwatch.ResourceMetricAlarms().Schema, map[string]interface{}{
+		"template": []interface{}{map[string]interface{}{
+			"alarm_description":   "template",
+			"comparison_operator": "GreaterThanThreshold",
+			"dimensions": map[string]interface{}{
+				"Environment": "production",
+			},
+			"evaluation_periods": 1,
+			names.AttrMetricName: "Errors",
+			names.AttrNamespace:  "AWS/Lambda",
+			"period":             60,
+			"statistic":          "Sum",
+			"threshold":          5,
+		}},
+		"alarm": []interface{}{
+			map[string]interface{}{
+				"alarm_name": "inherited",
+			},
+			map[string]interface{}{
+				"alarm_name":        "overridden",
+				"alarm_description": "overridden",
+				"dimensions": map[string]interface{}{
+					"FunctionName": "example",
+				},
+				"threshold": 10,
+			},
+			map[string]interface{}{
+				"alarm_name": "zero",
+				"threshold":  0,
+			},
+		},
+	})
+
+	config := cty.ObjectVal(map[string]cty.Value{
+		"alarm": cty.SetVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{"alarm_name": cty.StringVal("inherited"), "threshold": cty.NullVal(cty.Number)}),
+			cty.ObjectVal(map[string]cty.Value{"alarm_name": cty.StringVal("overridden"), "threshold": cty.NumberIntVal(10)}),
+			cty.ObjectVal(map[string]cty.Value{"alarm_name": cty.StringVal("zero"), "threshold": cty.NumberIntVal(0)}),
+		}),
+	})
+
+	alarms, err := tfcloudwatch.ExpandMetricAlarmsConfigs(d, tfcloudwatch.MetricAlarmsThresholdOverrides(config))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type expected struct {
+		description string
+		dimensions  map[string]interface{}
+		threshold   float64
+	}
+	want := map[string]expected{
+		"inherited": {
+			description: "template",
+			dimensions:  map[string]interface{}{"Environment": "production"},
+			threshold:   5,
+		},
+		"overridden": {
+			description: "overridden",
+			dimensions:  map[string]interface{}{"Environment": "production", "FunctionName": "example"},
+			threshold:   10,
+		},
+		"zero": {
+			description: "template",
+			dimensions:  map[string]interface{}{"Environment": "production"},
+			threshold:   0,
+		},
+	}
+
+	if got, want := len(alarms), len(want); got != want {
+		t.Fatalf("got %d alarms, want %d", got, want)
+	}
+
+	for _, alarm := range alarms {
+		name := alarm.Get("alarm_name").(string)
+		want, ok := want[name]
+		if !ok {
+			t.Fatalf("unexpected alarm %q", name)
+		}
+
+		if got := alarm.Get("alarm_description"); got != want.description {
+			t.Errorf("alarm %q alarm_description = %v, want %v", name, got, want.description)
+		}
+		if got := alarm.Get("comparison_operator"); got != "GreaterThanThreshold" {
+			t.Errorf("alarm %q comparison_operator = %v, want template value", name, got)
+		}
+		if got := alarm.Get("dimensions").(map[string]interface{}); fmt.Sprint(got) != fmt.Sprint(want.dimensions) {
+			t.Errorf("alarm %q dimensions = %v, want %v", name, got, want.dimensions)
+		}
+		if got := alarm.Get("threshold"); got != want.threshold {
+			t.Errorf("alarm %q threshold = %v, want %v", name, got, want.threshold)
+		}
+	}
+}
+
+func TestAccCloudWatchMetricAlarms_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var alarms []types.MetricAlarm
+	resourceName := "aws_cloudwatch_metric_alarms.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricAlarmsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricAlarmsConfig_basic(rName, 80),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMetricAlarmsExists(ctx, resourceName, &alarms),
+					resource.TestCheckResourceAttr(resourceName, "alarm.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "arns.%", acctest.Ct2),
+					resource.TestCheckResourceAttrSet(resourceName, fmt.Sprintf("arns.%s-1", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, fmt.Sprintf("arns.%s-2", rName)),
+					resource.TestCheckResourceAttr(resourceName, "template.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "template.0.threshold", "80"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				Config: testAccMetricAlarmsConfig_basic(rName, 90),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMetricAlarmsExists(ctx, resourceName, &alarms),
+					resource.TestCheckResourceAttr(resourceName, "arns.%", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "template.0.threshold", "90"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchMetricAlarms_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var alarms []types.MetricAlarm
+	resourceName := "aws_cloudwatch_metric_alarms.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricAlarmsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricAlarmsConfig_basic(rName, 80),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMetricAlarmsExists(ctx, resourceName, &alarms),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcloudwatch.ResourceMetricAlarms(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchMetricAlarms_alarms(t *testing.T) {
+	ctx := acctest.Context(t)
+	var alarms []types.MetricAlarm
+	resourceName := "aws_cloudwatch_metric_alarms.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricAlarmsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricAlarmsConfig_overrides(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMetricAlarmsExists(ctx, resourceName, &alarms),
+					resource.TestCheckResourceAttr(resourceName, "alarm.#", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, "arns.%", acctest.Ct3),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "alarm.*", map[string]string{
+						"alarm_name":        rName + "-override",
+						"alarm_description": "override",
+						"threshold":         "95",
+						"metric_query.#":    acctest.Ct1,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "alarm.*", map[string]string{
+						"alarm_name":     rName + "-math",
+						"metric_query.#": acctest.Ct2,
+					}),
+				),
+			},
+			{
+				Config: testAccMetricAlarmsConfig_basic(rName, 80),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMetricAlarmsExists(ctx, resourceName, &alarms),
+					resource.TestCheckResourceAttr(resourceName, "alarm.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "arns.%", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchMetricAlarms_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var alarms []types.MetricAlarm
+	resourceName := "aws_cloudwatch_metric_alarms.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricAlarmsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricAlarmsConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMetricAlarmsExists(ctx, resourceName, &alarms),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				Config: testAccMetricAlarmsConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMetricAlarmsExists(ctx, resourceName, &alarms),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMetricAlarmsExists(ctx context.Context, n string, v *[]types.MetricAlarm) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchClient(ctx)
+
+		alarmNames := testAccMetricAlarmsAlarmNames(rs)
+		output, err := tfcloudwatch.FindMetricAlarmsByNames(ctx, conn, alarmNames)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := len(output), len(alarmNames); got != want {
+			return fmt.Errorf("CloudWatch Metric Alarms (%s): found %d alarms, want %d", rs.Primary.ID, got, want)
+		}
+
+		*v = output
+
+		return nil
+	}
+}
+
+func testAccCheckMetricAlarmsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudwatch_metric_alarms" {
+				continue
+			}
+
+			output, err := tfcloudwatch.FindMetricAlarmsByNames(ctx, conn, testAccMetricAlarmsAlarmNames(rs))
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) > 0 {
+				return fmt.Errorf("CloudWatch Metric Alarms %s still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccMetricAlarmsAlarmNames(rs *terraform.ResourceState) []string {
+	var alarmNames []string
+
+	for k, v := range rs.Primary.Attributes {
+		if name, ok := strings.CutPrefix(k, "arns."); ok && name != "%" && v != "" {
+			alarmNames = append(alarmNames, name)
+		}
+	}
+
+	return alarmNames
+}
+
+func testAccMetricAlarmsConfig_basic(rName string, threshold int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarms" "test" {
+  template {
+    comparison_operator = "GreaterThanOrEqualToThreshold"
+    evaluation_periods  = 2
+    metric_name         = "CPUUtilization"
+    namespace           = "AWS/EC2"
+    period              = 120
+    statistic           = "Average"
+    threshold           = %[2]d
+  }
+
+  alarm {
+    alarm_name = "%[1]s-1"
+
+    dimensions = {
+      InstanceId = "i-abcd1234"
+    }
+  }
+
+  alarm {
+    alarm_name = "%[1]s-2"
+
+    dimensions = {
+      InstanceId = "i-efgh5678"
+    }
+  }
+}
+`, rName, threshold)
+}
+
+func testAccMetricAlarmsConfig_overrides(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarms" "test" {
+  template {
+    comparison_operator = "GreaterThanOrEqualToThreshold"
+    evaluation_periods  = 2
+    threshold           = 80
+  }
+
+  alarm {
+    alarm_name = "%[1]s-1"
+
+    metric_query {
+      id          = "m1"
+      return_data = true
+
+      metric {
+        metric_name = "CPUUtilization"
+        namespace   = "AWS/EC2"
+        period      = 120
+        stat        = "Average"
+
+        dimensions = {
+          InstanceId = "i-abcd1234"
+        }
+      }
+    }
+  }
+
+  alarm {
+    alarm_name        = "%[1]s-override"
+    alarm_description = "override"
+    threshold         = 95
+
+    metric_query {
+      id          = "m1"
+      return_data = true
+
+      metric {
+        metric_name = "CPUUtilization"
+        namespace   = "AWS/EC2"
+        period      = 120
+        stat        = "Maximum"
+
+        dimensions = {
+          InstanceId = "i-abcd1234"
+        }
+      }
+    }
+  }
+
+  alarm {
+    alarm_name = "%[1]s-math"
+
+    metric_query {
+      id          = "e1"
+      expression  = "m1 * 2"
+      label       = "Doubled CPU"
+      return_data = true
+    }
+
+    metric_query {
+      id = "m1"
+
+      metric {
+        metric_name = "CPUUtilization"
+        namespace   = "AWS/EC2"
+        period      = 120
+        stat        = "Average"
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccMetricAlarmsConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarms" "test" {
+  template {
+    comparison_operator = "GreaterThanOrEqualToThreshold"
+    evaluation_periods  = 2
+    metric_name         = "CPUUtilization"
+    namespace           = "AWS/EC2"
+    period              = 120
+    statistic           = "Average"
+    threshold           = 80
+  }
+
+  alarm {
+    alarm_name = "%[1]s-1"
+  }
+
+  alarm {
+    alarm_name = "%[1]s-2"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
@@ -23,7 +23,13 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
-	return []*types.ServicePackageSDKDataSource{}
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceMetricAlarms,
+			TypeName: "aws_cloudwatch_metric_alarms",
+			Name:     "Metric Alarms",
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
@@ -49,6 +55,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceMetricAlarms,
+			TypeName: "aws_cloudwatch_metric_alarms",
+			Name:     "Metric Alarms",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 		{
 			Factory:  resourceMetricStream,
 			TypeName: "aws_cloudwatch_metric_stream",
@@ -11,6 +11,7 @@ var (
 	ResourceTable                       = resourceTable
 	ResourceTableExport                 = resourceTableExport
 	ResourceTableItem                   = resourceTableItem
+	ResourceTableItems                  = resourceTableItems
 	ResourceTableReplica                = resourceTableReplica
 	ResourceTag                         = resourceTag
 	ResourceResourcePolicy              = newResourcePolicyResource
@@ -19,6 +20,8 @@ var (
 	ContributorInsightsParseResourceID           = contributorInsightsParseResourceID
 	ExpandTableItemAttributes                    = expandTableItemAttributes
 	ExpandTableItemQueryKey                      = expandTableItemQueryKey
+	ExpandTableItems                             = expandTableItems
+	ExpandTableItemsPlainJSON                    = expandTableItemsPlainJSON
 	FindContributorInsightsByTwoPartKey          = findContributorInsightsByTwoPartKey
 	FindGlobalTableByName                        = findGlobalTableByName
 	FindKinesisDataStreamDestinationByTwoPartKey = findKinesisDataStreamDestinationByTwoPartKey
@@ -26,11 +29,16 @@ var (
 	FindTableByName                              = findTableByName
 	FindTableExportByARN                         = findTableExportByARN
 	FindTableItemByTwoPartKey                    = findTableItemByTwoPartKey
+	FindTableItemsByKeys                         = findTableItemsByKeys
 	FindTag                                      = findTag
 	FlattenTableItemAttributes                   = flattenTableItemAttributes
+	FlattenTableItemsPlainJSON                   = flattenTableItemsPlainJSON
+	KeyTableItems                                = keyTableItems
 	ListTags                                     = listTags
 	RegionFromARN                                = regionFromARN
 	ReplicaForRegion                             = replicaForRegion
+	TableItemKey                                 = tableItemKey
+	TableItemsEqual                              = tableItemsEqual
 	TableNameFromARN                             = tableNameFromARN
 	TableReplicaParseResourceID                  = tableReplicaParseResourceID
 	UpdateDiffGSI                                = updateDiffGSI
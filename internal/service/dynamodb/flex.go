@@ -4,7 +4,9 @@
 package dynamodb
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
@@ -33,6 +35,87 @@ func flattenTableItemAttributes(apiObject map[string]awstypes.AttributeValue) (s
 	return tfjson.EncodeToString(m)
 }
 
+// expandTableItemsPlainJSON converts a JSON array of plain objects, e.g. `[{"id": "a", "count": 1}]`,
+// into DynamoDB items. Numbers are decoded as json.Number so that no precision is lost.
+func expandTableItemsPlainJSON(jsonStream string) ([]map[string]awstypes.AttributeValue, error) {
+	var s []map[string]any
+
+	dec := json.NewDecoder(strings.NewReader(jsonStream))
+	dec.UseNumber()
+	if err := dec.Decode(&s); err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAllWithError(s, func(m map[string]any) (map[string]awstypes.AttributeValue, error) {
+		return tfmaps.ApplyToAllValuesWithError(m, attributeFromPlain)
+	})
+}
+
+func flattenTableItemsPlainJSON(apiObjects []map[string]awstypes.AttributeValue) (string, error) {
+	s, err := tfslices.ApplyToAllWithError(apiObjects, func(apiObject map[string]awstypes.AttributeValue) (map[string]any, error) {
+		return tfmaps.ApplyToAllValuesWithError(apiObject, plainFromAttribute)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return tfjson.EncodeToString(s)
+}
+
+func attributeFromPlain(v any) (awstypes.AttributeValue, error) {
+	switch v := v.(type) {
+	case nil:
+		return &awstypes.AttributeValueMemberNULL{Value: true}, nil
+	case bool:
+		return &awstypes.AttributeValueMemberBOOL{Value: v}, nil
+	case json.Number:
+		return &awstypes.AttributeValueMemberN{Value: v.String()}, nil
+	case string:
+		return &awstypes.AttributeValueMemberS{Value: v}, nil
+	case []any:
+		l, err := tfslices.ApplyToAllWithError(v, attributeFromPlain)
+		if err != nil {
+			return nil, err
+		}
+		return &awstypes.AttributeValueMemberL{Value: l}, nil
+	case map[string]any:
+		m, err := tfmaps.ApplyToAllValuesWithError(v, attributeFromPlain)
+		if err != nil {
+			return nil, err
+		}
+		return &awstypes.AttributeValueMemberM{Value: m}, nil
+	}
+
+	return nil, fmt.Errorf("unexpected plain attribute type: %T", v)
+}
+
+func plainFromAttribute(a awstypes.AttributeValue) (any, error) {
+	switch a := a.(type) {
+	case *awstypes.AttributeValueMemberB:
+		return itypes.Base64Encode(a.Value), nil
+	case *awstypes.AttributeValueMemberBOOL:
+		return a.Value, nil
+	case *awstypes.AttributeValueMemberBS:
+		return tfslices.ApplyToAll(a.Value, itypes.Base64Encode), nil
+	case *awstypes.AttributeValueMemberL:
+		return tfslices.ApplyToAllWithError(a.Value, plainFromAttribute)
+	case *awstypes.AttributeValueMemberM:
+		return tfmaps.ApplyToAllValuesWithError(a.Value, plainFromAttribute)
+	case *awstypes.AttributeValueMemberN:
+		return json.Number(a.Value), nil
+	case *awstypes.AttributeValueMemberNS:
+		return tfslices.ApplyToAll(a.Value, func(v string) json.Number { return json.Number(v) }), nil
+	case *awstypes.AttributeValueMemberNULL:
+		return nil, nil
+	case *awstypes.AttributeValueMemberS:
+		return a.Value, nil
+	case *awstypes.AttributeValueMemberSS:
+		return a.Value, nil
+	}
+
+	return nil, fmt.Errorf("unexpected attribute type: %T", a)
+}
+
 func attributeFromRaw(v any) (awstypes.AttributeValue, error) {
 	m, ok := v.(map[string]any)
 	if !ok {
@@ -300,3 +300,89 @@ func TestFlattenTableItemAttributes(t *testing.T) {
 		})
 	}
 }
+
+func TestExpandTableItemsPlainJSON(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		input    string
+		expected []map[string]awstypes.AttributeValue
+	}{
+		"scalars": {
+			input: `[{"id":"one","count":12345678901234567890,"ratio":0.5,"enabled":true,"deleted":null}]`,
+			expected: []map[string]awstypes.AttributeValue{
+				{
+					names.AttrID:      &awstypes.AttributeValueMemberS{Value: "one"},
+					"count":           &awstypes.AttributeValueMemberN{Value: "12345678901234567890"},
+					"ratio":           &awstypes.AttributeValueMemberN{Value: "0.5"},
+					names.AttrEnabled: &awstypes.AttributeValueMemberBOOL{Value: true},
+					"deleted":         &awstypes.AttributeValueMemberNULL{Value: true},
+				},
+			},
+		},
+		"nested": {
+			input: `[{"id":"one","tags":["a",1],"attrs":{"k":"v"}},{"id":"two"}]`,
+			expected: []map[string]awstypes.AttributeValue{
+				{
+					names.AttrID: &awstypes.AttributeValueMemberS{Value: "one"},
+					"tags": &awstypes.AttributeValueMemberL{Value: []awstypes.AttributeValue{
+						&awstypes.AttributeValueMemberS{Value: "a"},
+						&awstypes.AttributeValueMemberN{Value: "1"},
+					}},
+					"attrs": &awstypes.AttributeValueMemberM{Value: map[string]awstypes.AttributeValue{
+						"k": &awstypes.AttributeValueMemberS{Value: "v"},
+					}},
+				},
+				{
+					names.AttrID: &awstypes.AttributeValueMemberS{Value: "two"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := tfdynamodb.ExpandTableItemsPlainJSON(tc.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !slices.EqualFunc(actual, tc.expected, func(a, b map[string]awstypes.AttributeValue) bool {
+				return maps.EqualFunc(a, b, attributeValuesEqual)
+			}) {
+				t.Fatalf("expected\n%s\ngot\n%s", tc.expected, actual)
+			}
+
+			flattened, err := tfdynamodb.FlattenTableItemsPlainJSON(actual)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			e, err := structure.NormalizeJsonString(tc.input)
+			if err != nil {
+				t.Fatalf("normalizing expected JSON: %s", err)
+			}
+
+			a, err := structure.NormalizeJsonString(flattened)
+			if err != nil {
+				t.Fatalf("normalizing returned JSON: %s", err)
+			}
+
+			if a != e {
+				t.Fatalf("expected\n%s\ngot\n%s", e, a)
+			}
+		})
+	}
+}
+
+func TestExpandTableItemsPlainJSON_invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{`{"id":"one"}`, `[1]`, `not json`} {
+		if _, err := tfdynamodb.ExpandTableItemsPlainJSON(input); err == nil {
+			t.Errorf("expected error for %s", input)
+		}
+	}
+}
@@ -75,6 +75,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_dynamodb_table_item",
 			Name:     "Table Item",
 		},
+		{
+			Factory:  resourceTableItems,
+			TypeName: "aws_dynamodb_table_items",
+			Name:     "Table Items",
+		},
 		{
 			Factory:  resourceTableReplica,
 			TypeName: "aws_dynamodb_table_replica",
@@ -0,0 +1,477 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// See https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_BatchWriteItem.html.
+	batchWriteItemMaxRequests = 25
+	// See https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_BatchGetItem.html.
+	batchGetItemMaxKeys = 100
+)
+
+// @SDKResource("aws_dynamodb_table_items", name="Table Items")
+func resourceTableItems() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTableItemsCreate,
+		ReadWithoutTimeout:   resourceTableItemsRead,
+		UpdateWithoutTimeout: resourceTableItemsUpdate,
+		DeleteWithoutTimeout: resourceTableItemsDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"hash_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"item": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateTableItem,
+				},
+				ExactlyOneOf: []string{"item", "items_json"},
+			},
+			"items_json": {
+				Type:                  schema.TypeString,
+				Optional:              true,
+				ValidateFunc:          validateTableItemsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
+				ExactlyOneOf:          []string{"item", "items_json"},
+			},
+			"range_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			names.AttrTableName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func validateTableItemsJSON(v interface{}, k string) (ws []string, errors []error) {
+	_, err := expandTableItemsPlainJSON(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("Invalid format of %q: %s", k, err))
+	}
+	return
+}
+
+func resourceTableItemsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName := d.Get(names.AttrTableName).(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+
+	items, err := expandTableItems(d.Get("item").(*schema.Set).List(), d.Get("items_json").(string))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	keyedItems, err := keyTableItems(tableName, hashKey, rangeKey, items)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	var requests []awstypes.WriteRequest
+	for _, item := range keyedItems {
+		requests = append(requests, awstypes.WriteRequest{
+			PutRequest: &awstypes.PutRequest{Item: item},
+		})
+	}
+
+	if err := batchWriteTableItems(ctx, conn, tableName, requests, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DynamoDB Table (%s) Items: %s", tableName, err)
+	}
+
+	// Several resources can manage distinct items in the same table, so the ID is not derived from the table name alone.
+	d.SetId(sdkid.PrefixedUniqueId(tableName + "|"))
+
+	return append(diags, resourceTableItemsRead(ctx, d, meta)...)
+}
+
+func resourceTableItemsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName := d.Get(names.AttrTableName).(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+	itemsJSON := d.Get("items_json").(string)
+	rawItems := d.Get("item").(*schema.Set).List()
+
+	items, err := expandTableItems(rawItems, itemsJSON)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if _, err := keyTableItems(tableName, hashKey, rangeKey, items); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	keys := tfslices.ApplyToAll(items, func(item map[string]awstypes.AttributeValue) map[string]awstypes.AttributeValue {
+		return expandTableItemQueryKey(item, hashKey, rangeKey)
+	})
+	output, err := findTableItemsByKeys(ctx, conn, tableName, keys)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DynamoDB Table Items (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table Items (%s): %s", d.Id(), err)
+	}
+
+	actualItems, err := keyTableItems(tableName, hashKey, rangeKey, output)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// The records exist, now test if they differ from what is desired.
+	// Items that have been deleted outside of Terraform are removed so that they are written again.
+	if itemsJSON != "" {
+		var drifted bool
+		var newItems []map[string]awstypes.AttributeValue
+		for _, item := range items {
+			actual, ok := actualItems[tableItemKey(tableName, hashKey, rangeKey, item)]
+			if !ok || !tableItemsEqual(actual, item) {
+				drifted = true
+			}
+			if ok {
+				newItems = append(newItems, actual)
+			}
+		}
+
+		if drifted {
+			v, err := flattenTableItemsPlainJSON(newItems)
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+			d.Set("items_json", v)
+		}
+	} else {
+		var newItems []string
+		for i, item := range items {
+			actual, ok := actualItems[tableItemKey(tableName, hashKey, rangeKey, item)]
+			switch {
+			case !ok:
+			case tableItemsEqual(actual, item):
+				newItems = append(newItems, rawItems[i].(string))
+			default:
+				v, err := flattenTableItemAttributes(actual)
+				if err != nil {
+					return sdkdiag.AppendFromErr(diags, err)
+				}
+				newItems = append(newItems, v)
+			}
+		}
+
+		d.Set("item", newItems)
+	}
+
+	return diags
+}
+
+func resourceTableItemsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	if d.HasChanges("item", "items_json") {
+		tableName := d.Get(names.AttrTableName).(string)
+		hashKey := d.Get("hash_key").(string)
+		rangeKey := d.Get("range_key").(string)
+
+		oItem, nItem := d.GetChange("item")
+		oItemsJSON, nItemsJSON := d.GetChange("items_json")
+
+		oldItems, err := expandTableItems(oItem.(*schema.Set).List(), oItemsJSON.(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		oldKeyedItems, err := keyTableItems(tableName, hashKey, rangeKey, oldItems)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		newItems, err := expandTableItems(nItem.(*schema.Set).List(), nItemsJSON.(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		newKeyedItems, err := keyTableItems(tableName, hashKey, rangeKey, newItems)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		var requests []awstypes.WriteRequest
+		for k, item := range newKeyedItems {
+			if old, ok := oldKeyedItems[k]; ok && tableItemsEqual(old, item) {
+				continue
+			}
+
+			requests = append(requests, awstypes.WriteRequest{
+				PutRequest: &awstypes.PutRequest{Item: item},
+			})
+		}
+		// Only items previously written by this resource are deleted.
+		for k, item := range oldKeyedItems {
+			if _, ok := newKeyedItems[k]; ok {
+				continue
+			}
+
+			requests = append(requests, awstypes.WriteRequest{
+				DeleteRequest: &awstypes.DeleteRequest{Key: expandTableItemQueryKey(item, hashKey, rangeKey)},
+			})
+		}
+
+		if err := batchWriteTableItems(ctx, conn, tableName, requests, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating DynamoDB Table Items (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTableItemsRead(ctx, d, meta)...)
+}
+
+func resourceTableItemsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName := d.Get(names.AttrTableName).(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+
+	items, err := expandTableItems(d.Get("item").(*schema.Set).List(), d.Get("items_json").(string))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	requests := tfslices.ApplyToAll(items, func(item map[string]awstypes.AttributeValue) awstypes.WriteRequest {
+		return awstypes.WriteRequest{
+			DeleteRequest: &awstypes.DeleteRequest{Key: expandTableItemQueryKey(item, hashKey, rangeKey)},
+		}
+	})
+
+	log.Printf("[DEBUG] Deleting DynamoDB Table Items: %s", d.Id())
+	err = batchWriteTableItems(ctx, conn, tableName, requests, d.Timeout(schema.TimeoutDelete))
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DynamoDB Table Items (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// expandTableItems returns the items configured either as DynamoDB JSON objects or as a plain JSON list.
+func expandTableItems(tfList []interface{}, itemsJSON string) ([]map[string]awstypes.AttributeValue, error) {
+	if itemsJSON != "" {
+		return expandTableItemsPlainJSON(itemsJSON)
+	}
+
+	return tfslices.ApplyToAllWithError(tfList, func(v interface{}) (map[string]awstypes.AttributeValue, error) {
+		return expandTableItemAttributes(v.(string))
+	})
+}
+
+// keyTableItems indexes items by their primary key, returning an error if an item is missing a key attribute or if two items have the same key.
+func keyTableItems(tableName, hashKey, rangeKey string, items []map[string]awstypes.AttributeValue) (map[string]map[string]awstypes.AttributeValue, error) {
+	m := make(map[string]map[string]awstypes.AttributeValue, len(items))
+
+	for _, item := range items {
+		if _, ok := item[hashKey]; !ok {
+			return nil, fmt.Errorf("item is missing hash key attribute %q", hashKey)
+		}
+		if _, ok := item[rangeKey]; rangeKey != "" && !ok {
+			return nil, fmt.Errorf("item is missing range key attribute %q", rangeKey)
+		}
+
+		k := tableItemKey(tableName, hashKey, rangeKey, item)
+		if _, ok := m[k]; ok {
+			return nil, fmt.Errorf("duplicate item key: %s", k)
+		}
+		m[k] = item
+	}
+
+	return m, nil
+}
+
+// tableItemKey returns the key by which keyTableItems indexes an item.
+// Numeric key values are normalized so that e.g. "1.50" and "1.5" identify the same item.
+func tableItemKey(tableName, hashKey, rangeKey string, item map[string]awstypes.AttributeValue) string {
+	return tableItemCreateResourceID(tableName, hashKey, rangeKey, normalizeTableItem(item))
+}
+
+// tableItemsEqual reports whether two items hold the same values once normalized.
+func tableItemsEqual(a, b map[string]awstypes.AttributeValue) bool {
+	return reflect.DeepEqual(normalizeTableItem(a), normalizeTableItem(b))
+}
+
+func normalizeTableItem(item map[string]awstypes.AttributeValue) map[string]awstypes.AttributeValue {
+	m := make(map[string]awstypes.AttributeValue, len(item))
+
+	for k, v := range item {
+		m[k] = normalizeTableItemAttribute(v)
+	}
+
+	return m
+}
+
+// normalizeTableItemAttribute returns the attribute value in the form in which DynamoDB returns it.
+// DynamoDB does not preserve the representation of numbers (e.g. "1.0" is returned as "1") or the order of set members.
+func normalizeTableItemAttribute(v awstypes.AttributeValue) awstypes.AttributeValue {
+	switch v := v.(type) {
+	case *awstypes.AttributeValueMemberBS:
+		value := slices.Clone(v.Value)
+		slices.SortFunc(value, bytes.Compare)
+		return &awstypes.AttributeValueMemberBS{Value: value}
+	case *awstypes.AttributeValueMemberL:
+		return &awstypes.AttributeValueMemberL{Value: tfslices.ApplyToAll(v.Value, normalizeTableItemAttribute)}
+	case *awstypes.AttributeValueMemberM:
+		return &awstypes.AttributeValueMemberM{Value: normalizeTableItem(v.Value)}
+	case *awstypes.AttributeValueMemberN:
+		return &awstypes.AttributeValueMemberN{Value: normalizeTableItemNumber(v.Value)}
+	case *awstypes.AttributeValueMemberNS:
+		value := tfslices.ApplyToAll(v.Value, normalizeTableItemNumber)
+		slices.Sort(value)
+		return &awstypes.AttributeValueMemberNS{Value: value}
+	case *awstypes.AttributeValueMemberSS:
+		value := slices.Clone(v.Value)
+		slices.Sort(value)
+		return &awstypes.AttributeValueMemberSS{Value: value}
+	default:
+		return v
+	}
+}
+
+// normalizeTableItemNumber returns the canonical form of a number, so that e.g. "1.0", "1" and "1e0" compare equal.
+func normalizeTableItemNumber(v string) string {
+	d, err := decimal.NewFromString(v)
+	if err != nil {
+		return v
+	}
+
+	return d.String()
+}
+
+// batchWriteTableItems writes the requests in chunks, retrying any unprocessed items until the timeout elapses.
+func batchWriteTableItems(ctx context.Context, conn *dynamodb.Client, tableName string, requests []awstypes.WriteRequest, timeout time.Duration) error {
+	for _, chunk := range tfslices.Chunks(requests, batchWriteItemMaxRequests) {
+		err := tfresource.Retry(ctx, timeout, func() *retry.RetryError {
+			input := &dynamodb.BatchWriteItemInput{
+				RequestItems: map[string][]awstypes.WriteRequest{
+					tableName: chunk,
+				},
+			}
+
+			output, err := conn.BatchWriteItem(ctx, input)
+
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+
+			if v := output.UnprocessedItems[tableName]; len(v) > 0 {
+				chunk = v
+				return retry.RetryableError(fmt.Errorf("%d unprocessed items", len(v)))
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func findTableItemsByKeys(ctx context.Context, conn *dynamodb.Client, tableName string, keys []map[string]awstypes.AttributeValue) ([]map[string]awstypes.AttributeValue, error) {
+	var items []map[string]awstypes.AttributeValue
+
+	for _, chunk := range tfslices.Chunks(keys, batchGetItemMaxKeys) {
+		err := tfresource.Retry(ctx, propagationTimeout, func() *retry.RetryError {
+			input := &dynamodb.BatchGetItemInput{
+				RequestItems: map[string]awstypes.KeysAndAttributes{
+					tableName: {
+						ConsistentRead: aws.Bool(true),
+						Keys:           chunk,
+					},
+				},
+			}
+
+			output, err := conn.BatchGetItem(ctx, input)
+
+			if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+				return retry.NonRetryableError(&retry.NotFoundError{
+					LastError:   err,
+					LastRequest: input,
+				})
+			}
+
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+
+			items = append(items, output.Responses[tableName]...)
+
+			if v, ok := output.UnprocessedKeys[tableName]; ok && len(v.Keys) > 0 {
+				chunk = v.Keys
+				return retry.RetryableError(fmt.Errorf("%d unprocessed keys", len(v.Keys)))
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return items, nil
+}
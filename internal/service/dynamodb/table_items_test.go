@@ -0,0 +1,443 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestTableItemsEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		a, b     map[string]awstypes.AttributeValue
+		expected bool
+	}{
+		"equivalent numbers": {
+			a: map[string]awstypes.AttributeValue{
+				"n": &awstypes.AttributeValueMemberN{Value: "1.0"},
+				"m": &awstypes.AttributeValueMemberM{Value: map[string]awstypes.AttributeValue{
+					"n": &awstypes.AttributeValueMemberN{Value: "2.50"},
+				}},
+			},
+			b: map[string]awstypes.AttributeValue{
+				"n": &awstypes.AttributeValueMemberN{Value: "1"},
+				"m": &awstypes.AttributeValueMemberM{Value: map[string]awstypes.AttributeValue{
+					"n": &awstypes.AttributeValueMemberN{Value: "2.5"},
+				}},
+			},
+			expected: true,
+		},
+		"different numbers": {
+			a: map[string]awstypes.AttributeValue{
+				"n": &awstypes.AttributeValueMemberN{Value: "1.5"},
+			},
+			b: map[string]awstypes.AttributeValue{
+				"n": &awstypes.AttributeValueMemberN{Value: "1"},
+			},
+		},
+		"reordered sets": {
+			a: map[string]awstypes.AttributeValue{
+				"ns": &awstypes.AttributeValueMemberNS{Value: []string{"2", "1.0"}},
+				"ss": &awstypes.AttributeValueMemberSS{Value: []string{"b", "a"}},
+			},
+			b: map[string]awstypes.AttributeValue{
+				"ns": &awstypes.AttributeValueMemberNS{Value: []string{"1", "2"}},
+				"ss": &awstypes.AttributeValueMemberSS{Value: []string{"a", "b"}},
+			},
+			expected: true,
+		},
+		"reordered list": {
+			a: map[string]awstypes.AttributeValue{
+				"l": &awstypes.AttributeValueMemberL{Value: []awstypes.AttributeValue{
+					&awstypes.AttributeValueMemberS{Value: "b"},
+					&awstypes.AttributeValueMemberS{Value: "a"},
+				}},
+			},
+			b: map[string]awstypes.AttributeValue{
+				"l": &awstypes.AttributeValueMemberL{Value: []awstypes.AttributeValue{
+					&awstypes.AttributeValueMemberS{Value: "a"},
+					&awstypes.AttributeValueMemberS{Value: "b"},
+				}},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfdynamodb.TableItemsEqual(testCase.a, testCase.b), testCase.expected; got != want {
+				t.Errorf("TableItemsEqual() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestKeyTableItems(t *testing.T) {
+	t.Parallel()
+
+	// Items as returned by DynamoDB.
+	items := []map[string]awstypes.AttributeValue{
+		{
+			"pk": &awstypes.AttributeValueMemberN{Value: "1.5"},
+			"sk": &awstypes.AttributeValueMemberN{Value: "-0.25"},
+		},
+		{
+			"pk": &awstypes.AttributeValueMemberN{Value: "1"},
+			"sk": &awstypes.AttributeValueMemberN{Value: "2"},
+		},
+	}
+
+	keyedItems, err := tfdynamodb.KeyTableItems("t", "pk", "sk", items)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		item     map[string]awstypes.AttributeValue
+		expected bool
+	}{
+		"fractional keys": {
+			item: map[string]awstypes.AttributeValue{
+				"pk": &awstypes.AttributeValueMemberN{Value: "1.5"},
+				"sk": &awstypes.AttributeValueMemberN{Value: "-0.25"},
+			},
+			expected: true,
+		},
+		"non-canonical fractional keys": {
+			item: map[string]awstypes.AttributeValue{
+				"pk": &awstypes.AttributeValueMemberN{Value: "1.50"},
+				"sk": &awstypes.AttributeValueMemberN{Value: "-25e-2"},
+			},
+			expected: true,
+		},
+		"non-canonical integer keys": {
+			item: map[string]awstypes.AttributeValue{
+				"pk": &awstypes.AttributeValueMemberN{Value: "1.0"},
+				"sk": &awstypes.AttributeValueMemberN{Value: "2.00"},
+			},
+			expected: true,
+		},
+		"different keys": {
+			item: map[string]awstypes.AttributeValue{
+				"pk": &awstypes.AttributeValueMemberN{Value: "1.5"},
+				"sk": &awstypes.AttributeValueMemberN{Value: "0.25"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, ok := keyedItems[tfdynamodb.TableItemKey("t", "pk", "sk", testCase.item)]
+			if got, want := ok, testCase.expected; got != want {
+				t.Errorf("found = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestAccDynamoDBTableItems_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(rName, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemsExists(ctx, resourceName),
+					testAccCheckTableItemCount(ctx, rName, 30),
+					resource.TestCheckResourceAttr(resourceName, "hash_key", "hashKey"),
+					resource.TestCheckResourceAttr(resourceName, "item.#", "30"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrTableName, rName),
+				),
+			},
+			{
+				Config: testAccTableItemsConfig_basic(rName, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemsExists(ctx, resourceName),
+					testAccCheckTableItemCount(ctx, rName, 5),
+					resource.TestCheckResourceAttr(resourceName, "item.#", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemsExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfdynamodb.ResourceTableItems(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_itemsJSON(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_itemsJSON(rName, 120),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemsExists(ctx, resourceName),
+					testAccCheckTableItemCount(ctx, rName, 120),
+					resource.TestCheckResourceAttr(resourceName, "range_key", "rangeKey"),
+				),
+			},
+			{
+				Config: testAccTableItemsConfig_itemsJSON(rName, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemsExists(ctx, resourceName),
+					testAccCheckTableItemCount(ctx, rName, 10),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_unmanagedItems(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_unmanagedItems(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemsExists(ctx, resourceName),
+					testAccCheckTableItemCount(ctx, rName, 4),
+				),
+			},
+			{
+				Config: testAccTableItemsConfig_unmanagedItemsRemoved(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTableItemsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dynamodb_table_items" {
+				continue
+			}
+
+			keys, err := testAccTableItemsKeys(rs)
+			if err != nil {
+				return err
+			}
+
+			output, err := tfdynamodb.FindTableItemsByKeys(ctx, conn, rs.Primary.Attributes[names.AttrTableName], keys)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) > 0 {
+				return fmt.Errorf("DynamoDB Table Items %s still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTableItemsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
+
+		keys, err := testAccTableItemsKeys(rs)
+		if err != nil {
+			return err
+		}
+
+		output, err := tfdynamodb.FindTableItemsByKeys(ctx, conn, rs.Primary.Attributes[names.AttrTableName], keys)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := len(output), len(keys); got != want {
+			return fmt.Errorf("DynamoDB Table Items %s: found %d items, expected %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccTableItemsKeys(rs *terraform.ResourceState) ([]map[string]awstypes.AttributeValue, error) {
+	var tfList []interface{}
+	for k, v := range rs.Primary.Attributes {
+		if k != "item.#" && strings.HasPrefix(k, "item.") {
+			tfList = append(tfList, v)
+		}
+	}
+
+	items, err := tfdynamodb.ExpandTableItems(tfList, rs.Primary.Attributes["items_json"])
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(items, func(item map[string]awstypes.AttributeValue) map[string]awstypes.AttributeValue {
+		return tfdynamodb.ExpandTableItemQueryKey(item, rs.Primary.Attributes["hash_key"], rs.Primary.Attributes["range_key"])
+	}), nil
+}
+
+func testAccTableItemsConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "hashKey"
+  range_key    = "rangeKey"
+
+  attribute {
+    name = "hashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "rangeKey"
+    type = "N"
+  }
+}
+`, rName)
+}
+
+func testAccTableItemsConfig_basic(rName string, count int) string {
+	return acctest.ConfigCompose(testAccTableItemsConfig_base(rName), fmt.Sprintf(`
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  range_key  = aws_dynamodb_table.test.range_key
+
+  item = [for i in range(%[1]d) : jsonencode({
+    hashKey  = { S = "item-${i}" }
+    rangeKey = { N = tostring(i) }
+    value    = { S = "value-${i}" }
+  })]
+}
+`, count))
+}
+
+func testAccTableItemsConfig_itemsJSON(rName string, count int) string {
+	return acctest.ConfigCompose(testAccTableItemsConfig_base(rName), fmt.Sprintf(`
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  range_key  = aws_dynamodb_table.test.range_key
+
+  items_json = jsonencode([for i in range(%[1]d) : {
+    hashKey  = "item-${i}"
+    rangeKey = i
+    enabled  = i %% 2 == 0
+    tags     = ["a", "b"]
+  }])
+}
+`, count))
+}
+
+func testAccTableItemsConfig_unmanagedItems(rName string) string {
+	return acctest.ConfigCompose(testAccTableItemsConfig_base(rName), `
+resource "aws_dynamodb_table_item" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  range_key  = aws_dynamodb_table.test.range_key
+
+  item = jsonencode({
+    hashKey  = { S = "unmanaged" }
+    rangeKey = { N = "0" }
+  })
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  range_key  = aws_dynamodb_table.test.range_key
+
+  items_json = jsonencode([for i in range(3) : {
+    hashKey  = "managed"
+    rangeKey = i
+  }])
+}
+`)
+}
+
+func testAccTableItemsConfig_unmanagedItemsRemoved(rName string) string {
+	return acctest.ConfigCompose(testAccTableItemsConfig_base(rName), `
+resource "aws_dynamodb_table_item" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  range_key  = aws_dynamodb_table.test.range_key
+
+  item = jsonencode({
+    hashKey  = { S = "unmanaged" }
+    rangeKey = { N = "0" }
+  })
+}
+`)
+}
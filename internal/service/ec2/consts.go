@@ -267,6 +267,23 @@ const (
 	sriovNetSupportSimple = "simple"
 )
 
+// See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_ClientVpnRoute.html#API_ClientVpnRoute_Contents.
+const (
+	clientVPNRouteOriginAddRoute = "add-route"
+)
+
+const (
+	instanceAMIUpdateStrategyReplace           = "replace"
+	instanceAMIUpdateStrategyReplaceRootVolume = "replace_root_volume"
+)
+
+func instanceAMIUpdateStrategy_Values() []string {
+	return []string{
+		instanceAMIUpdateStrategyReplace,
+		instanceAMIUpdateStrategyReplaceRootVolume,
+	}
+}
+
 const (
 	targetStorageTierStandard awstypes.TargetStorageTier = "standard"
 )
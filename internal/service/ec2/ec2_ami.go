@@ -36,6 +36,11 @@ const (
 	amiRetryMinTimeout = 3 * time.Second
 )
 
+const (
+	imageDeregistrationProtectionEnabledPrefix       = "enabled"
+	imageDeregistrationProtectionEnabledWithCooldown = "enabled-with-cooldown"
+)
+
 // @SDKResource("aws_ami", name="AMI")
 // @Tags(identifierAttribute="id")
 // @Testing(tagsTest=false)
@@ -84,6 +89,15 @@ func resourceAMI() *schema.Resource {
 				DiffSuppressFunc:      verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Minute),
 				DiffSuppressOnRefresh: true,
 			},
+			"deregistration_protection_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"deregistration_protection_with_cooldown": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				RequiredWith: []string{"deregistration_protection_enabled"},
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -269,6 +283,12 @@ func resourceAMI() *schema.Resource {
 				ForceNew: true,
 				Default:  sriovNetSupportSimple,
 			},
+			names.AttrState: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(enum.Slice(awstypes.ImageStateAvailable, awstypes.ImageStateDisabled), false),
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"tpm_support": {
@@ -384,6 +404,18 @@ func resourceAMICreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 	}
 
+	if d.Get("deregistration_protection_enabled").(bool) {
+		if err := enableImageDeregistrationProtection(ctx, conn, d.Id(), d.Get("deregistration_protection_with_cooldown").(bool)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating EC2 AMI (%s): %s", name, err)
+		}
+	}
+
+	if v := awstypes.ImageState(d.Get(names.AttrState).(string)); v == awstypes.ImageStateDisabled {
+		if err := disableImage(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating EC2 AMI (%s): %s", name, err)
+		}
+	}
+
 	return append(diags, resourceAMIRead(ctx, d, meta)...)
 }
 
@@ -392,7 +424,7 @@ func resourceAMIRead(ctx context.Context, d *schema.ResourceData, meta interface
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
 	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, ec2PropagationTimeout, func() (interface{}, error) {
-		return findImageByID(ctx, conn, d.Id())
+		return findImageIncludingDisabledByID(ctx, conn, d.Id())
 	}, d.IsNewResource())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -431,6 +463,13 @@ func resourceAMIRead(ctx context.Context, d *schema.ResourceData, meta interface
 	d.Set("boot_mode", image.BootMode)
 	d.Set(names.AttrDescription, image.Description)
 	d.Set("deprecation_time", image.DeprecationTime)
+	if v := aws.ToString(image.DeregistrationProtection); strings.HasPrefix(v, imageDeregistrationProtectionEnabledPrefix) {
+		d.Set("deregistration_protection_enabled", true)
+		d.Set("deregistration_protection_with_cooldown", v == imageDeregistrationProtectionEnabledWithCooldown)
+	} else {
+		d.Set("deregistration_protection_enabled", false)
+		d.Set("deregistration_protection_with_cooldown", false)
+	}
 	d.Set("ena_support", image.EnaSupport)
 	d.Set("hypervisor", image.Hypervisor)
 	d.Set("image_location", image.ImageLocation)
@@ -447,6 +486,7 @@ func resourceAMIRead(ctx context.Context, d *schema.ResourceData, meta interface
 	d.Set("root_device_name", image.RootDeviceName)
 	d.Set("root_snapshot_id", amiRootSnapshotId(*image))
 	d.Set("sriov_net_support", image.SriovNetSupport)
+	d.Set(names.AttrState, image.State)
 	d.Set("tpm_support", image.TpmSupport)
 	d.Set("usage_operation", image.UsageOperation)
 	d.Set("virtualization_type", image.VirtualizationType)
@@ -468,6 +508,13 @@ func resourceAMIUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
+	// Re-enable a disabled AMI before making any other changes to it.
+	if d.HasChange(names.AttrState) && awstypes.ImageState(d.Get(names.AttrState).(string)) == awstypes.ImageStateAvailable {
+		if err := enableImage(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 AMI (%s): %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange(names.AttrDescription) {
 		err := updateDescription(ctx, conn, d.Id(), d.Get(names.AttrDescription).(string))
 		if err != nil {
@@ -487,6 +534,24 @@ func resourceAMIUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 	}
 
+	if d.HasChanges("deregistration_protection_enabled", "deregistration_protection_with_cooldown") {
+		if d.Get("deregistration_protection_enabled").(bool) {
+			if err := enableImageDeregistrationProtection(ctx, conn, d.Id(), d.Get("deregistration_protection_with_cooldown").(bool)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 AMI (%s): %s", d.Id(), err)
+			}
+		} else {
+			if err := disableImageDeregistrationProtection(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 AMI (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange(names.AttrState) && awstypes.ImageState(d.Get(names.AttrState).(string)) == awstypes.ImageStateDisabled {
+		if err := disableImage(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 AMI (%s): %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceAMIRead(ctx, d, meta)...)
 }
 
@@ -604,6 +669,83 @@ func disableImageDeprecation(ctx context.Context, conn *ec2.Client, id string) e
 	return nil
 }
 
+func enableImageDeregistrationProtection(ctx context.Context, conn *ec2.Client, id string, withCooldown bool) error {
+	input := &ec2.EnableImageDeregistrationProtectionInput{
+		ImageId:      aws.String(id),
+		WithCooldown: aws.Bool(withCooldown),
+	}
+
+	_, err := conn.EnableImageDeregistrationProtection(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("enabling deregistration protection: %w", err)
+	}
+
+	err = waitImageDeregistrationProtectionUpdated(ctx, conn, id, true)
+
+	if err != nil {
+		return fmt.Errorf("enabling deregistration protection: waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
+func disableImageDeregistrationProtection(ctx context.Context, conn *ec2.Client, id string) error {
+	input := &ec2.DisableImageDeregistrationProtectionInput{
+		ImageId: aws.String(id),
+	}
+
+	_, err := conn.DisableImageDeregistrationProtection(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("disabling deregistration protection: %w", err)
+	}
+
+	err = waitImageDeregistrationProtectionUpdated(ctx, conn, id, false)
+
+	if err != nil {
+		return fmt.Errorf("disabling deregistration protection: waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
+func disableImage(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) error {
+	input := &ec2.DisableImageInput{
+		ImageId: aws.String(id),
+	}
+
+	_, err := conn.DisableImage(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("disabling: %w", err)
+	}
+
+	if _, err := waitImageDisabled(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("disabling: waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
+func enableImage(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) error {
+	input := &ec2.EnableImageInput{
+		ImageId: aws.String(id),
+	}
+
+	_, err := conn.EnableImage(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("enabling: %w", err)
+	}
+
+	if _, err := waitImageEnabled(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("enabling: waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
 func expandBlockDeviceMappingForAMIEBSBlockDevice(tfMap map[string]interface{}) awstypes.BlockDeviceMapping {
 	apiObject := awstypes.BlockDeviceMapping{
 		Ebs: &awstypes.EbsBlockDevice{},
@@ -799,7 +941,7 @@ const imageDeprecationPropagationTimeout = 2 * time.Minute
 
 func waitImageDescriptionUpdated(ctx context.Context, conn *ec2.Client, imageID, expectedValue string) error {
 	return tfresource.WaitUntil(ctx, imageDeprecationPropagationTimeout, func() (bool, error) {
-		output, err := findImageByID(ctx, conn, imageID)
+		output, err := findImageIncludingDisabledByID(ctx, conn, imageID)
 
 		if tfresource.NotFound(err) {
 			return false, nil
@@ -826,7 +968,7 @@ func waitImageDeprecationTimeUpdated(ctx context.Context, conn *ec2.Client, imag
 	expected = expected.Round(time.Minute)
 
 	return tfresource.WaitUntil(ctx, imageDeprecationPropagationTimeout, func() (bool, error) {
-		output, err := findImageByID(ctx, conn, imageID)
+		output, err := findImageIncludingDisabledByID(ctx, conn, imageID)
 
 		if tfresource.NotFound(err) {
 			return false, nil
@@ -857,7 +999,7 @@ func waitImageDeprecationTimeUpdated(ctx context.Context, conn *ec2.Client, imag
 
 func waitImageDeprecationTimeDisabled(ctx context.Context, conn *ec2.Client, imageID string) error {
 	return tfresource.WaitUntil(ctx, imageDeprecationPropagationTimeout, func() (bool, error) {
-		output, err := findImageByID(ctx, conn, imageID)
+		output, err := findImageIncludingDisabledByID(ctx, conn, imageID)
 
 		if tfresource.NotFound(err) {
 			return false, nil
@@ -875,3 +1017,24 @@ func waitImageDeprecationTimeDisabled(ctx context.Context, conn *ec2.Client, ima
 		},
 	)
 }
+
+func waitImageDeregistrationProtectionUpdated(ctx context.Context, conn *ec2.Client, imageID string, expectedEnabled bool) error {
+	return tfresource.WaitUntil(ctx, imageDeprecationPropagationTimeout, func() (bool, error) {
+		output, err := findImageIncludingDisabledByID(ctx, conn, imageID)
+
+		if tfresource.NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		return strings.HasPrefix(aws.ToString(output.DeregistrationProtection), imageDeregistrationProtectionEnabledPrefix) == expectedEnabled, nil
+	},
+		tfresource.WaitOpts{
+			Delay:      amiRetryDelay,
+			MinTimeout: amiRetryMinTimeout,
+		},
+	)
+}
@@ -49,6 +49,11 @@ func dataSourceAMIIDs() *schema.Resource {
 				Default:  false,
 				Optional: true,
 			},
+			"include_disabled": {
+				Type:     schema.TypeBool,
+				Default:  false,
+				Optional: true,
+			},
 			"name_regex": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -78,6 +83,7 @@ func dataSourceAMIIDsRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	input := &ec2.DescribeImagesInput{
 		IncludeDeprecated: aws.Bool(d.Get("include_deprecated").(bool)),
+		IncludeDisabled:   aws.Bool(d.Get("include_disabled").(bool)),
 		Owners:            flex.ExpandStringValueList(d.Get("owners").([]interface{})),
 	}
 
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -83,6 +84,34 @@ func TestAccEC2AMIIDsDataSource_includeDeprecated(t *testing.T) {
 	})
 }
 
+func TestAccEC2AMIIDsDataSource_includeDisabled(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_ami_ids.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAMIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAMIIDsDataSourceConfig_includeDisabled(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "ids.#", "0"),
+				),
+			},
+			{
+				Config: testAccAMIIDsDataSourceConfig_includeDisabled(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, "ids.0", "aws_ami.test", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
 const testAccAMIIDsDataSourceConfig_basic = `
 data "aws_ami_ids" "test" {
   owners = ["099720109477"]
@@ -147,3 +176,17 @@ data "aws_ami_ids" "test" {
 }
 `, includeDeprecated)
 }
+
+func testAccAMIIDsDataSourceConfig_includeDisabled(rName string, includeDisabled bool) string {
+	return acctest.ConfigCompose(testAccAMIConfig_state(rName, "disabled"), fmt.Sprintf(`
+data "aws_ami_ids" "test" {
+  owners           = ["self"]
+  include_disabled = %[1]t
+
+  filter {
+    name   = "name"
+    values = [aws_ami.test.name]
+  }
+}
+`, includeDisabled))
+}
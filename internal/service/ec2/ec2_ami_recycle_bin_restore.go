@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_ami_recycle_bin_restore", name="AMI Recycle Bin Restore")
+func newAMIRecycleBinRestoreResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &amiRecycleBinRestoreResource{}
+
+	r.SetDefaultCreateTimeout(amiRetryTimeout)
+
+	return r, nil
+}
+
+type amiRecycleBinRestoreResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithTimeouts
+}
+
+func (*amiRecycleBinRestoreResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ami_recycle_bin_restore"
+}
+
+func (r *amiRecycleBinRestoreResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"image_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *amiRecycleBinRestoreResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data amiRecycleBinRestoreResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	imageID := data.ImageID.ValueString()
+	input := &ec2.RestoreImageFromRecycleBinInput{
+		ImageId: fwflex.StringFromFramework(ctx, data.ImageID),
+	}
+
+	_, err := conn.RestoreImageFromRecycleBin(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("restoring EC2 AMI (%s) from Recycle Bin", imageID), err.Error())
+
+		return
+	}
+
+	output, err := waitImageAvailable(ctx, conn, imageID, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for EC2 AMI (%s) Recycle Bin restore", imageID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = data.ImageID
+	data.Name = fwflex.StringToFramework(ctx, output.Name)
+	data.State = fwflex.StringValueToFramework(ctx, output.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *amiRecycleBinRestoreResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data amiRecycleBinRestoreResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	output, err := findImageIncludingDisabledByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 AMI (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ImageID = data.ID
+	data.Name = fwflex.StringToFramework(ctx, output.Name)
+	data.State = fwflex.StringValueToFramework(ctx, output.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *amiRecycleBinRestoreResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	// A restore can't be undone.
+	// Removing the resource from state leaves the restored AMI in place.
+}
+
+type amiRecycleBinRestoreResourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	ImageID  types.String   `tfsdk:"image_id"`
+	Name     types.String   `tfsdk:"name"`
+	State    types.String   `tfsdk:"state"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
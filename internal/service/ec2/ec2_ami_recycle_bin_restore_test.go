@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2AMIRecycleBinRestore_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ami, restored awstypes.Image
+	amiResourceName := "aws_ami.test"
+	resourceName := "aws_ami_recycle_bin_restore.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAMIRecycleBinRestoreConfig_image(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAMIExists(ctx, amiResourceName, &ami),
+				),
+			},
+			{
+				// Removing the AMI from the configuration deregisters it into the Recycle Bin.
+				Config: testAccAMIRecycleBinRestoreConfig_base(rName),
+			},
+			{
+				Config: testAccAMIRecycleBinRestoreConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAMIExists(ctx, resourceName, &restored),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrID, "terraform_data.image_id", "output"),
+					resource.TestCheckResourceAttrPair(resourceName, "image_id", "terraform_data.image_id", "output"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "available"),
+				),
+			},
+			{
+				// Removing the restore leaves the AMI in place.
+				Config: testAccAMIRecycleBinRestoreConfig_noRule(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAMIRecycleBinRestoreImageAvailable(ctx, &restored),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccAMIRecycleBinRestoreDeregisterImage(ctx, t, &restored)
+				},
+				Config: testAccAMIRecycleBinRestoreConfig_noRule(rName),
+			},
+		},
+	})
+}
+
+func testAccCheckAMIRecycleBinRestoreImageAvailable(ctx context.Context, v *awstypes.Image) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindImageIncludingDisabledByID(ctx, conn, aws.ToString(v.ImageId))
+
+		if err != nil {
+			return err
+		}
+
+		if state := output.State; state != awstypes.ImageStateAvailable {
+			return fmt.Errorf("EC2 AMI (%s) state = %s, want %s", aws.ToString(v.ImageId), state, awstypes.ImageStateAvailable)
+		}
+
+		return nil
+	}
+}
+
+func testAccAMIRecycleBinRestoreDeregisterImage(ctx context.Context, t *testing.T, v *awstypes.Image) {
+	t.Helper()
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+	_, err := conn.DeregisterImage(ctx, &ec2.DeregisterImageInput{
+		ImageId: v.ImageId,
+	})
+
+	if err != nil {
+		t.Fatalf("deregistering EC2 AMI (%s): %s", aws.ToString(v.ImageId), err)
+	}
+}
+
+func testAccAMIRecycleBinRestoreConfig_rule(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_rbin_rule" "test" {
+  description   = %[1]q
+  resource_type = "EC2_IMAGE"
+
+  resource_tags {
+    resource_tag_key   = "Name"
+    resource_tag_value = %[1]q
+  }
+
+  retention_period {
+    retention_period_value = 1
+    retention_period_unit  = "DAYS"
+  }
+}
+`, rName)
+}
+
+// testAccAMIRecycleBinRestoreConfig_imageID remembers the AMI's ID after the AMI has been removed from the configuration.
+func testAccAMIRecycleBinRestoreConfig_imageID(input string) string {
+	return fmt.Sprintf(`
+resource "terraform_data" "image_id" {
+  input = %[1]s
+
+  lifecycle {
+    ignore_changes = [input]
+  }
+}
+`, input)
+}
+
+func testAccAMIRecycleBinRestoreConfig_noRule(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAMIConfig_base(rName),
+		testAccAMIRecycleBinRestoreConfig_imageID(`"unused"`),
+	)
+}
+
+func testAccAMIRecycleBinRestoreConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAMIRecycleBinRestoreConfig_noRule(rName),
+		testAccAMIRecycleBinRestoreConfig_rule(rName),
+	)
+}
+
+func testAccAMIRecycleBinRestoreConfig_image(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAMIConfig_base(rName),
+		testAccAMIRecycleBinRestoreConfig_rule(rName),
+		testAccAMIRecycleBinRestoreConfig_imageID("aws_ami.test.id"),
+		fmt.Sprintf(`
+resource "aws_ami" "test" {
+  ena_support         = true
+  name                = %[1]q
+  root_device_name    = "/dev/sda1"
+  virtualization_type = "hvm"
+
+  ebs_block_device {
+    device_name = "/dev/sda1"
+    snapshot_id = aws_ebs_snapshot.test.id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_rbin_rule.test]
+}
+`, rName))
+}
+
+func testAccAMIRecycleBinRestoreConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAMIRecycleBinRestoreConfig_base(rName), `
+resource "aws_ami_recycle_bin_restore" "test" {
+  image_id = terraform_data.image_id.output
+}
+`)
+}
@@ -70,11 +70,15 @@ func resourceInstance() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"ami": {
 				Type:         schema.TypeString,
-				ForceNew:     true,
 				Computed:     true,
 				Optional:     true,
 				AtLeastOneOf: []string{"ami", names.AttrLaunchTemplate},
 			},
+			"ami_update_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(instanceAMIUpdateStrategy_Values(), false),
+			},
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -218,6 +222,10 @@ func resourceInstance() *schema.Resource {
 					},
 				},
 			},
+			"delete_replaced_root_volume": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"disable_api_stop": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -899,6 +907,15 @@ func resourceInstance() *schema.Resource {
 			customdiff.ComputedIf("launch_template.0.name", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("launch_template.0.id")
 			}),
+			customdiff.ForceNewIf("ami", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.Get("ami_update_strategy").(string) != instanceAMIUpdateStrategyReplaceRootVolume
+			}),
+			// Replacing the root volume in place changes its volume ID.
+			// SetNewComputed only accepts top-level keys, so the whole block is marked unknown. It is left as planned
+			// when it also has configured changes, which are applied to the new root volume.
+			customdiff.ComputedIf("root_block_device", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.Id() != "" && diff.HasChange("ami") && !diff.HasChange("root_block_device") && diff.Get("ami_update_strategy").(string) == instanceAMIUpdateStrategyReplaceRootVolume
+			}),
 			customdiff.ForceNewIf("user_data", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.Get("user_data_replace_on_change").(bool)
 			}),
@@ -1953,9 +1970,44 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
-	if d.HasChange("root_block_device.0") && !d.IsNewResource() {
+	// A change to ami is only planned as an in-place update when ami_update_strategy is "replace_root_volume".
+	// The instance ID and network interfaces are retained; only the root volume is restored from the new AMI.
+	if d.HasChange("ami") && !d.IsNewResource() {
+		input := &ec2.CreateReplaceRootVolumeTaskInput{
+			ClientToken:              aws.String(id.UniqueId()),
+			DeleteReplacedRootVolume: aws.Bool(d.Get("delete_replaced_root_volume").(bool)),
+			ImageId:                  aws.String(d.Get("ami").(string)),
+			InstanceId:               aws.String(d.Id()),
+		}
+
+		output, err := conn.CreateReplaceRootVolumeTask(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s): creating replace root volume task: %s", d.Id(), err)
+		}
+
+		taskID := aws.ToString(output.ReplaceRootVolumeTask.ReplaceRootVolumeTaskId)
+
+		if _, err := waitReplaceRootVolumeTaskSucceeded(ctx, conn, taskID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EC2 Instance (%s) replace root volume task (%s): %s", d.Id(), taskID, err)
+		}
+	}
+
+	// root_block_device is planned as unknown when only the root volume is replaced, there is nothing to modify.
+	if d.HasChange("root_block_device.0") && !d.IsNewResource() && d.GetRawPlan().GetAttr("root_block_device").IsKnown() {
 		volID := d.Get("root_block_device.0.volume_id").(string)
 
+		// The root volume in state no longer exists if it was just replaced.
+		if d.HasChange("ami") {
+			instance, err := findInstanceByID(ctx, conn, d.Id())
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading EC2 Instance (%s): %s", d.Id(), err)
+			}
+
+			volID = getRootVolID(instance)
+		}
+
 		input := &ec2.ModifyVolumeInput{
 			VolumeId: aws.String(volID),
 		}
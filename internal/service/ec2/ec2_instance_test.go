@@ -2216,6 +2216,92 @@ func TestAccEC2Instance_changeInstanceTypeReplace(t *testing.T) {
 	})
 }
 
+func TestAccEC2Instance_AMIUpdateStrategy_replace(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after awstypes.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_amiUpdateStrategy(rName, "data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id", "replace"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttrPair(resourceName, "ami", "data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "ami_update_strategy", "replace"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_amiUpdateStrategy(rName, "data.aws_ami.amzn-linux-2023-ami.id", "replace"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &after),
+					testAccCheckInstanceRecreated(&before, &after),
+					resource.TestCheckResourceAttrPair(resourceName, "ami", "data.aws_ami.amzn-linux-2023-ami", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2Instance_AMIUpdateStrategy_replaceRootVolume(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after awstypes.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_amiUpdateStrategy(rName, "data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id", "replace_root_volume"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttrPair(resourceName, "ami", "data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "ami_update_strategy", "replace_root_volume"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ami_update_strategy", "user_data_replace_on_change"},
+			},
+			{
+				Config: testAccInstanceConfig_amiUpdateStrategy(rName, "data.aws_ami.amzn-linux-2023-ami.id", "replace_root_volume"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &after),
+					testAccCheckInstanceNotRecreated(&before, &after),
+					resource.TestCheckResourceAttrPair(resourceName, "ami", "data.aws_ami.amzn-linux-2023-ami", names.AttrID),
+					func(s *terraform.State) error {
+						if before, after := aws.ToString(before.PrivateIpAddress), aws.ToString(after.PrivateIpAddress); before != after {
+							return fmt.Errorf("EC2 Instance private IP changed (%s/%s)", before, after)
+						}
+
+						return nil
+					},
+					func(s *terraform.State) error {
+						before, after := testAccInstanceRootVolumeID(&before), testAccInstanceRootVolumeID(&after)
+
+						if before == after {
+							return fmt.Errorf("EC2 Instance root volume not replaced (%s)", before)
+						}
+
+						return resource.TestCheckResourceAttr(resourceName, "root_block_device.0.volume_id", after)(s)
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2Instance_changeInstanceTypeAndUserData(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.Instance
@@ -6089,6 +6175,37 @@ resource "aws_instance" "test" {
 `, instanceType, rName))
 }
 
+func testAccInstanceRootVolumeID(v *awstypes.Instance) string {
+	for _, bd := range v.BlockDeviceMappings {
+		if aws.ToString(bd.DeviceName) == aws.ToString(v.RootDeviceName) && bd.Ebs != nil {
+			return aws.ToString(bd.Ebs.VolumeId)
+		}
+	}
+
+	return ""
+}
+
+func testAccInstanceConfig_amiUpdateStrategy(rName, ami, strategy string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		testAccLatestAmazonLinux2023AMIConfig(),
+		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro"),
+		testAccInstanceVPCConfig(rName, false, 0),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami                         = %[2]s
+  ami_update_strategy         = %[3]q
+  delete_replaced_root_volume = true
+  instance_type               = data.aws_ec2_instance_type_offering.available.instance_type
+  subnet_id                   = aws_subnet.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, ami, strategy))
+}
+
 func testAccInstanceConfig_typeReplace(rName, instanceType string) string {
 	arch := acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI()
 	archs := "x86_64"
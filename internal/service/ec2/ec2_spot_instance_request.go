@@ -68,6 +68,10 @@ func resourceSpotInstanceRequest() *schema.Resource {
 			delete(s, "instance_market_options")
 			delete(s, "spot_instance_request_id")
 
+			// Spot instance requests are always replaced on AMI change.
+			delete(s, "ami_update_strategy")
+			delete(s, "delete_replaced_root_volume")
+
 			s["block_duration_minutes"] = &schema.Schema{
 				Type:         schema.TypeInt,
 				Optional:     true,
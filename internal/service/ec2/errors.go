@@ -13,6 +13,7 @@ import (
 )
 
 const (
+	errCodeAccessDenied                                            = "AccessDenied"
 	errCodeAnalysisExistsForNetworkInsightsPath                    = "AnalysisExistsForNetworkInsightsPath"
 	errCodeAuthFailure                                             = "AuthFailure"
 	errCodeClientInvalidHostIDNotFound                             = "Client.InvalidHostID.NotFound"
@@ -23,6 +24,7 @@ const (
 	errCodeIPAMOrganizationAccountNotRegistered                    = "IpamOrganizationAccountNotRegistered"
 	errCodeIncorrectState                                          = "IncorrectState"
 	errCodeInsufficientInstanceCapacity                            = "InsufficientInstanceCapacity"
+	errCodeInvalidAction                                           = "InvalidAction"
 	errCodeInvalidAMIIDNotFound                                    = "InvalidAMIID.NotFound"
 	errCodeInvalidAMIIDUnavailable                                 = "InvalidAMIID.Unavailable"
 	errCodeInvalidAddressNotFound                                  = "InvalidAddress.NotFound"
@@ -134,6 +136,7 @@ const (
 	errCodeTransitGatewayMulticastGroupMemberNotFound              = "TransitGatewayMulticastGroupMember.NotFound"
 	errCodeTransitGatewayMulticastGroupSourceNotFound              = "TransitGatewayMulticastGroupSource.NotFound"
 	errCodeTransitGatewayRouteTablePropagationNotFound             = "TransitGatewayRouteTablePropagation.NotFound"
+	errCodeUnauthorizedOperation                                   = "UnauthorizedOperation"
 	errCodeUnsupportedOperation                                    = "UnsupportedOperation"
 	errCodeVPNConnectionLimitExceeded                              = "VpnConnectionLimitExceeded"
 	errCodeVPNGatewayLimitExceeded                                 = "VpnGatewayLimitExceeded"
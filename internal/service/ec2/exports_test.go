@@ -40,6 +40,7 @@ var (
 	ResourceIPAMResourceDiscovery                         = resourceIPAMResourceDiscovery
 	ResourceIPAMResourceDiscoveryAssociation              = resourceIPAMResourceDiscoveryAssociation
 	ResourceIPAMScope                                     = resourceIPAMScope
+	ResourceIPAMSubnets                                   = resourceIPAMSubnets
 	ResourceImageBlockPublicAccess                        = resourceImageBlockPublicAccess
 	ResourceInstance                                      = resourceInstance
 	ResourceInstanceConnectEndpoint                       = newInstanceConnectEndpointResource
@@ -69,6 +70,7 @@ var (
 	ResourceRouteTableAssociation                         = resourceRouteTableAssociation
 	ResourceSecurityGroupEgressRule                       = newSecurityGroupEgressRuleResource
 	ResourceSecurityGroupIngressRule                      = newSecurityGroupIngressRuleResource
+	ResourceSecurityGroupVPCAssociation                   = newSecurityGroupVPCAssociationResource
 	ResourceSnapshotCreateVolumePermission                = resourceSnapshotCreateVolumePermission
 	ResourceSpotDataFeedSubscription                      = resourceSpotDataFeedSubscription
 	ResourceSpotFleetRequest                              = resourceSpotFleetRequest
@@ -128,7 +130,9 @@ var (
 	FindAvailabilityZones                                      = findAvailabilityZones
 	FindCapacityReservationByID                                = findCapacityReservationByID
 	FindCarrierGatewayByID                                     = findCarrierGatewayByID
+	FindClientVPNAddedRoutesByEndpointID                       = findClientVPNAddedRoutesByEndpointID
 	FindClientVPNAuthorizationRuleByThreePartKey               = findClientVPNAuthorizationRuleByThreePartKey
+	FindClientVPNAuthorizationRulesByEndpointID                = findClientVPNAuthorizationRulesByEndpointID
 	FindClientVPNEndpointByID                                  = findClientVPNEndpointByID
 	FindClientVPNNetworkAssociationByTwoPartKey                = findClientVPNNetworkAssociationByTwoPartKey
 	FindClientVPNRouteByThreePartKey                           = findClientVPNRouteByThreePartKey
@@ -152,6 +156,7 @@ var (
 	FindIPAMResourceDiscoveryAssociationByID                   = findIPAMResourceDiscoveryAssociationByID
 	FindIPAMResourceDiscoveryByID                              = findIPAMResourceDiscoveryByID
 	FindIPAMScopeByID                                          = findIPAMScopeByID
+	FindImageIncludingDisabledByID                             = findImageIncludingDisabledByID
 	FindImageLaunchPermission                                  = findImageLaunchPermission
 	FindInstanceConnectEndpointByID                            = findInstanceConnectEndpointByID
 	FindInstanceMetadataDefaults                               = findInstanceMetadataDefaults
@@ -185,6 +190,7 @@ var (
 	FindSecurityGroupByID                                      = findSecurityGroupByID
 	FindSecurityGroupEgressRuleByID                            = findSecurityGroupEgressRuleByID
 	FindSecurityGroupIngressRuleByID                           = findSecurityGroupIngressRuleByID
+	FindSecurityGroupVPCAssociationByTwoPartKey                = findSecurityGroupVPCAssociationByTwoPartKey
 	FindSnapshot                                               = findSnapshot
 	FindSnapshotByID                                           = findSnapshotByID
 	FindSpotDatafeedSubscription                               = findSpotDatafeedSubscription
@@ -240,6 +246,7 @@ var (
 	FlattenNetworkInterfacePrivateIPAddresses                  = flattenNetworkInterfacePrivateIPAddresses
 	FlattenSecurityGroups                                      = flattenSecurityGroups
 	IPAMServicePrincipal                                       = ipamServicePrincipal
+	IPAMSubnetsLayout                                          = ipamSubnetsLayout
 	InstanceMigrateState                                       = instanceMigrateState
 	InternetGatewayAttachmentParseResourceID                   = internetGatewayAttachmentParseResourceID
 	KeyPairMigrateState                                        = keyPairMigrateState
@@ -271,6 +278,7 @@ var (
 	VPCDHCPOptionsAssociationParseResourceID                   = vpcDHCPOptionsAssociationParseResourceID
 	VPCMigrateState                                            = vpcMigrateState
 	VPNGatewayRoutePropagationParseID                          = vpnGatewayRoutePropagationParseID
+	WaitClientVPNRouteCreated                                  = waitClientVPNRouteCreated
 	WaitVolumeAttachmentCreated                                = waitVolumeAttachmentCreated
 )
 
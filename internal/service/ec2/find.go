@@ -598,6 +598,54 @@ func findInstanceTypeOfferings(ctx context.Context, conn *ec2.Client, input *ec2
 	return output, nil
 }
 
+func findReplaceRootVolumeTasks(ctx context.Context, conn *ec2.Client, input *ec2.DescribeReplaceRootVolumeTasksInput) ([]awstypes.ReplaceRootVolumeTask, error) {
+	var output []awstypes.ReplaceRootVolumeTask
+
+	pages := ec2.NewDescribeReplaceRootVolumeTasksPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ReplaceRootVolumeTasks...)
+	}
+
+	return output, nil
+}
+
+func findReplaceRootVolumeTask(ctx context.Context, conn *ec2.Client, input *ec2.DescribeReplaceRootVolumeTasksInput) (*awstypes.ReplaceRootVolumeTask, error) {
+	output, err := findReplaceRootVolumeTasks(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findReplaceRootVolumeTaskByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.ReplaceRootVolumeTask, error) {
+	input := &ec2.DescribeReplaceRootVolumeTasksInput{
+		ReplaceRootVolumeTaskIds: []string{id},
+	}
+
+	output, err := findReplaceRootVolumeTask(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.ReplaceRootVolumeTaskId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func findInternetGateway(ctx context.Context, conn *ec2.Client, input *ec2.DescribeInternetGatewaysInput) (*awstypes.InternetGateway, error) {
 	output, err := findInternetGateways(ctx, conn, input)
 
@@ -2060,6 +2108,81 @@ func findSecurityGroupRulesBySecurityGroupID(ctx context.Context, conn *ec2.Clie
 	return findSecurityGroupRules(ctx, conn, input)
 }
 
+func findSecurityGroupVPCAssociation(ctx context.Context, conn *ec2.Client, input *ec2.DescribeSecurityGroupVpcAssociationsInput) (*awstypes.SecurityGroupVpcAssociation, error) {
+	output, err := findSecurityGroupVPCAssociations(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findSecurityGroupVPCAssociations(ctx context.Context, conn *ec2.Client, input *ec2.DescribeSecurityGroupVpcAssociationsInput) ([]awstypes.SecurityGroupVpcAssociation, error) {
+	var output []awstypes.SecurityGroupVpcAssociation
+
+	pages := ec2.NewDescribeSecurityGroupVpcAssociationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidGroupNotFound, errCodeInvalidSecurityGroupIDNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.SecurityGroupVpcAssociations...)
+	}
+
+	return output, nil
+}
+
+func findSecurityGroupVPCAssociationsBySecurityGroupID(ctx context.Context, conn *ec2.Client, id string) ([]awstypes.SecurityGroupVpcAssociation, error) {
+	input := &ec2.DescribeSecurityGroupVpcAssociationsInput{
+		Filters: newAttributeFilterList(map[string]string{
+			"group-id": id,
+		}),
+	}
+
+	return findSecurityGroupVPCAssociations(ctx, conn, input)
+}
+
+func findSecurityGroupVPCAssociationByTwoPartKey(ctx context.Context, conn *ec2.Client, groupID, vpcID string) (*awstypes.SecurityGroupVpcAssociation, error) {
+	input := &ec2.DescribeSecurityGroupVpcAssociationsInput{
+		Filters: newAttributeFilterList(map[string]string{
+			"group-id": groupID,
+			"vpc-id":   vpcID,
+		}),
+	}
+
+	output, err := findSecurityGroupVPCAssociation(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := output.State; state == awstypes.SecurityGroupVpcAssociationStateDisassociated {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.GroupId) != groupID || aws.ToString(output.VpcId) != vpcID {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func findNetworkInterfaces(ctx context.Context, conn *ec2.Client, input *ec2.DescribeNetworkInterfacesInput) ([]awstypes.NetworkInterface, error) {
 	var output []awstypes.NetworkInterface
 
@@ -3289,6 +3412,14 @@ func findClientVPNAuthorizationRules(ctx context.Context, conn *ec2.Client, inpu
 	return output, nil
 }
 
+func findClientVPNAuthorizationRulesByEndpointID(ctx context.Context, conn *ec2.Client, endpointID string) ([]awstypes.AuthorizationRule, error) {
+	input := &ec2.DescribeClientVpnAuthorizationRulesInput{
+		ClientVpnEndpointId: aws.String(endpointID),
+	}
+
+	return findClientVPNAuthorizationRules(ctx, conn, input)
+}
+
 func findClientVPNAuthorizationRuleByThreePartKey(ctx context.Context, conn *ec2.Client, endpointID, targetNetworkCIDR, accessGroupID string) (*awstypes.AuthorizationRule, error) {
 	filters := map[string]string{
 		"destination-cidr": targetNetworkCIDR,
@@ -3413,6 +3544,19 @@ func findClientVPNRouteByThreePartKey(ctx context.Context, conn *ec2.Client, end
 	return findClientVPNRoute(ctx, conn, input)
 }
 
+// findClientVPNAddedRoutesByEndpointID returns the routes added to a Client VPN endpoint with CreateClientVpnRoute,
+// excluding those created automatically for target network associations.
+func findClientVPNAddedRoutesByEndpointID(ctx context.Context, conn *ec2.Client, endpointID string) ([]awstypes.ClientVpnRoute, error) {
+	input := &ec2.DescribeClientVpnRoutesInput{
+		ClientVpnEndpointId: aws.String(endpointID),
+		Filters: newAttributeFilterList(map[string]string{
+			"origin": clientVPNRouteOriginAddRoute,
+		}),
+	}
+
+	return findClientVPNRoutes(ctx, conn, input)
+}
+
 func findCarrierGateway(ctx context.Context, conn *ec2.Client, input *ec2.DescribeCarrierGatewaysInput) (*awstypes.CarrierGateway, error) {
 	output, err := findCarrierGateways(ctx, conn, input)
 
@@ -4197,6 +4341,21 @@ func findImageByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.
 		ImageIds: []string{id},
 	}
 
+	return findImageByIDWithInput(ctx, conn, id, input)
+}
+
+// findImageIncludingDisabledByID also returns disabled AMIs, which DescribeImages omits by default.
+// It is used by the AMI resources, which can disable the AMIs that they manage.
+func findImageIncludingDisabledByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.Image, error) {
+	input := &ec2.DescribeImagesInput{
+		ImageIds:        []string{id},
+		IncludeDisabled: aws.Bool(true),
+	}
+
+	return findImageByIDWithInput(ctx, conn, id, input)
+}
+
+func findImageByIDWithInput(ctx context.Context, conn *ec2.Client, id string, input *ec2.DescribeImagesInput) (*awstypes.Image, error) {
 	output, err := findImage(ctx, conn, input)
 
 	if err != nil {
@@ -0,0 +1,588 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/netip"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_vpc_ipam_subnets", name="IPAM Subnets")
+func resourceIPAMSubnets() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIPAMSubnetsCreate,
+		ReadWithoutTimeout:   resourceIPAMSubnetsRead,
+		UpdateWithoutTimeout: resourceIPAMSubnetsUpdate,
+		DeleteWithoutTimeout: resourceIPAMSubnetsDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: resourceIPAMSubnetsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrAvailabilityZones: {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"cidr": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"cidr", "netmask_length"},
+				ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"disallowed_cidrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.Any(
+						verify.ValidIPv4CIDRNetworkAddress,
+						// Follow the numbers used for netmask_length
+						validation.IsCIDRNetwork(0, 32),
+					),
+				},
+			},
+			"ipam_pool_allocation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipam_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"netmask_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 32),
+				ExactlyOneOf: []string{"cidr", "netmask_length"},
+			},
+			"subnet": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAvailabilityZone: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrCIDRBlock: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"subnet_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tier": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"map_public_ip_on_launch": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"netmask_length": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(16, 28),
+						},
+						names.AttrTags: {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			names.AttrVPCID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceIPAMSubnetsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	ipamPoolID := d.Get("ipam_pool_id").(string)
+	input := &ec2.AllocateIpamPoolCidrInput{
+		ClientToken: aws.String(id.UniqueId()),
+		IpamPoolId:  aws.String(ipamPoolID),
+	}
+
+	// Allocate the CIDR that was previewed during plan so that the subnet layout matches.
+	if v, ok := d.GetOk("cidr"); ok {
+		input.Cidr = aws.String(v.(string))
+	} else if v, ok := d.GetOk("netmask_length"); ok {
+		input.NetmaskLength = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("disallowed_cidrs"); ok && v.(*schema.Set).Len() > 0 {
+		input.DisallowedCidrs = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	output, err := conn.AllocateIpamPoolCidr(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IPAM Subnets CIDR allocation: %s", err)
+	}
+
+	allocationID := aws.ToString(output.IpamPoolAllocation.IpamPoolAllocationId)
+	d.SetId(ipamPoolCIDRAllocationCreateResourceID(allocationID, ipamPoolID))
+
+	_, err = tfresource.RetryWhenNotFound(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return findIPAMPoolAllocationByTwoPartKey(ctx, conn, allocationID, ipamPoolID)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IPAM Subnets (%s) CIDR allocation create: %s", d.Id(), err)
+	}
+
+	tiers := d.Get("tier").([]interface{})
+	azs := flex.ExpandStringValueList(d.Get(names.AttrAvailabilityZones).([]interface{}))
+	cidrBlocks, err := ipamSubnetsLayout(aws.ToString(output.IpamPoolAllocation.Cidr), expandIPAMSubnetsTierNetmaskLengths(tiers), len(azs))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IPAM Subnets (%s): %s", d.Id(), err)
+	}
+
+	subnetIDs := make([]string, len(cidrBlocks))
+	for i, cidrBlock := range cidrBlocks {
+		tier := tiers[i/len(azs)].(map[string]interface{})
+		subnetID, err := createIPAMSubnet(ctx, conn, d.Get(names.AttrVPCID).(string), azs[i%len(azs)], cidrBlock, tier, d.Timeout(schema.TimeoutCreate))
+
+		if err != nil {
+			// Record the subnets created so far so that they are cleaned up on destroy.
+			d.Set("subnet_ids", subnetIDs)
+
+			return sdkdiag.AppendErrorf(diags, "creating IPAM Subnets (%s): %s", d.Id(), err)
+		}
+
+		subnetIDs[i] = subnetID
+	}
+
+	d.Set("subnet_ids", subnetIDs)
+
+	return append(diags, resourceIPAMSubnetsRead(ctx, d, meta)...)
+}
+
+func resourceIPAMSubnetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	allocationID, poolID, err := ipamPoolCIDRAllocationParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	allocation, err := findIPAMPoolAllocationByTwoPartKey(ctx, conn, allocationID, poolID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IPAM Subnets (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IPAM Subnets (%s): %s", d.Id(), err)
+	}
+
+	cidr := aws.ToString(allocation.Cidr)
+	d.Set("cidr", cidr)
+	d.Set(names.AttrDescription, allocation.Description)
+	d.Set("ipam_pool_allocation_id", allocation.IpamPoolAllocationId)
+	d.Set("ipam_pool_id", poolID)
+
+	tiers := d.Get("tier").([]interface{})
+	azs := flex.ExpandStringValueList(d.Get(names.AttrAvailabilityZones).([]interface{}))
+	cidrBlocks, err := ipamSubnetsLayout(cidr, expandIPAMSubnetsTierNetmaskLengths(tiers), len(azs))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IPAM Subnets (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("subnet", flattenIPAMSubnetsLayout(cidrBlocks, tiers, azs)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting subnet: %s", err)
+	}
+
+	// Subnets deleted out of band are recorded as empty IDs and recreated on the next apply.
+	subnetIDs := flex.ExpandStringValueList(d.Get("subnet_ids").([]interface{}))
+	for i, subnetID := range subnetIDs {
+		if subnetID == "" {
+			continue
+		}
+
+		_, err := findSubnetByID(ctx, conn, subnetID)
+
+		if tfresource.NotFound(err) {
+			log.Printf("[WARN] IPAM Subnets (%s) EC2 Subnet (%s) not found", d.Id(), subnetID)
+			subnetIDs[i] = ""
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading IPAM Subnets (%s) EC2 Subnet (%s): %s", d.Id(), subnetID, err)
+		}
+	}
+	d.Set("subnet_ids", subnetIDs)
+
+	return diags
+}
+
+func resourceIPAMSubnetsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	tiers := d.Get("tier").([]interface{})
+	azs := flex.ExpandStringValueList(d.Get(names.AttrAvailabilityZones).([]interface{}))
+	cidrBlocks, err := ipamSubnetsLayout(d.Get("cidr").(string), expandIPAMSubnetsTierNetmaskLengths(tiers), len(azs))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating IPAM Subnets (%s): %s", d.Id(), err)
+	}
+
+	o, _ := d.GetChange("subnet_ids")
+	subnetIDs := make([]string, len(cidrBlocks))
+	copy(subnetIDs, flex.ExpandStringValueList(o.([]interface{})))
+
+	for i, cidrBlock := range cidrBlocks {
+		tierKey := fmt.Sprintf("tier.%d", i/len(azs))
+		tier := tiers[i/len(azs)].(map[string]interface{})
+
+		if subnetIDs[i] == "" {
+			subnetID, err := createIPAMSubnet(ctx, conn, d.Get(names.AttrVPCID).(string), azs[i%len(azs)], cidrBlock, tier, d.Timeout(schema.TimeoutUpdate))
+
+			if err != nil {
+				d.Set("subnet_ids", subnetIDs)
+
+				return sdkdiag.AppendErrorf(diags, "updating IPAM Subnets (%s): %s", d.Id(), err)
+			}
+
+			subnetIDs[i] = subnetID
+			continue
+		}
+
+		if key := tierKey + ".map_public_ip_on_launch"; d.HasChange(key) {
+			if err := modifySubnetMapPublicIPOnLaunch(ctx, conn, subnetIDs[i], d.Get(key).(bool)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating IPAM Subnets (%s): %s", d.Id(), err)
+			}
+		}
+
+		if key := tierKey + ".tags"; d.HasChange(key) {
+			o, n := d.GetChange(key)
+
+			if err := updateTags(ctx, conn, subnetIDs[i], o, n); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating IPAM Subnets (%s) EC2 Subnet (%s) tags: %s", d.Id(), subnetIDs[i], err)
+			}
+		}
+	}
+
+	d.Set("subnet_ids", subnetIDs)
+
+	return append(diags, resourceIPAMSubnetsRead(ctx, d, meta)...)
+}
+
+func resourceIPAMSubnetsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	allocationID, poolID, err := ipamPoolCIDRAllocationParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	for _, subnetID := range flex.ExpandStringValueList(d.Get("subnet_ids").([]interface{})) {
+		if subnetID == "" {
+			continue
+		}
+
+		if err := deleteIPAMSubnet(ctx, conn, subnetID, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting IPAM Subnets (%s): %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting IPAM Subnets CIDR allocation: %s", d.Id())
+	_, err = conn.ReleaseIpamPoolAllocation(ctx, &ec2.ReleaseIpamPoolAllocationInput{
+		Cidr:                 aws.String(d.Get("cidr").(string)),
+		IpamPoolAllocationId: aws.String(allocationID),
+		IpamPoolId:           aws.String(poolID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidIPAMPoolIdNotFound) || tfawserr.ErrMessageContains(err, errCodeInvalidParameterCombination, "No allocation found") {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IPAM Subnets (%s) CIDR allocation: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceIPAMSubnetsCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// The layout is fixed once the CIDR has been allocated.
+	if diff.Id() != "" {
+		// Subnets deleted out of band are recreated in place.
+		for _, v := range diff.Get("subnet_ids").([]interface{}) {
+			if v.(string) == "" {
+				if err := diff.SetNewComputed("subnet_ids"); err != nil {
+					return fmt.Errorf("setting subnet_ids to computed: %s", err)
+				}
+				break
+			}
+		}
+
+		return nil
+	}
+
+	for _, key := range []string{names.AttrAvailabilityZones, "disallowed_cidrs", "ipam_pool_id", "netmask_length", "tier"} {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	var cidr string
+	switch v := diff.GetRawConfig().GetAttr("cidr"); {
+	case !v.IsKnown():
+		return nil
+	case !v.IsNull():
+		cidr = v.AsString()
+	default:
+		// Preview the next available CIDR so that the subnet layout is shown in the plan.
+		conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+		ipamPoolID := diff.Get("ipam_pool_id").(string)
+		input := &ec2.AllocateIpamPoolCidrInput{
+			ClientToken:     aws.String(id.UniqueId()),
+			IpamPoolId:      aws.String(ipamPoolID),
+			NetmaskLength:   aws.Int32(int32(diff.Get("netmask_length").(int))),
+			PreviewNextCidr: aws.Bool(true),
+		}
+
+		if v, ok := diff.GetOk("disallowed_cidrs"); ok && v.(*schema.Set).Len() > 0 {
+			input.DisallowedCidrs = flex.ExpandStringValueSet(v.(*schema.Set))
+		}
+
+		output, err := conn.AllocateIpamPoolCidr(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("previewing next CIDR from IPAM Pool (%s): %w", ipamPoolID, err)
+		}
+
+		if output == nil || output.IpamPoolAllocation == nil {
+			return fmt.Errorf("previewing next CIDR from IPAM Pool (%s): empty response", ipamPoolID)
+		}
+
+		cidr = aws.ToString(output.IpamPoolAllocation.Cidr)
+
+		if err := diff.SetNew("cidr", cidr); err != nil {
+			return fmt.Errorf("setting cidr: %w", err)
+		}
+	}
+
+	tiers := diff.Get("tier").([]interface{})
+	azs := flex.ExpandStringValueList(diff.Get(names.AttrAvailabilityZones).([]interface{}))
+	cidrBlocks, err := ipamSubnetsLayout(cidr, expandIPAMSubnetsTierNetmaskLengths(tiers), len(azs))
+
+	if err != nil {
+		return err
+	}
+
+	if err := diff.SetNew("subnet", flattenIPAMSubnetsLayout(cidrBlocks, tiers, azs)); err != nil {
+		return fmt.Errorf("setting subnet: %w", err)
+	}
+
+	return nil
+}
+
+func createIPAMSubnet(ctx context.Context, conn *ec2.Client, vpcID, availabilityZone, cidrBlock string, tier map[string]interface{}, timeout time.Duration) (string, error) {
+	input := &ec2.CreateSubnetInput{
+		AvailabilityZone:  aws.String(availabilityZone),
+		CidrBlock:         aws.String(cidrBlock),
+		TagSpecifications: tagSpecificationsFromMap(ctx, tier[names.AttrTags].(map[string]interface{}), awstypes.ResourceTypeSubnet),
+		VpcId:             aws.String(vpcID),
+	}
+
+	output, err := conn.CreateSubnet(ctx, input)
+
+	if err != nil {
+		return "", fmt.Errorf("creating EC2 Subnet (%s): %w", cidrBlock, err)
+	}
+
+	subnetID := aws.ToString(output.Subnet.SubnetId)
+
+	if _, err := waitSubnetAvailable(ctx, conn, subnetID, timeout); err != nil {
+		return subnetID, fmt.Errorf("waiting for EC2 Subnet (%s) create: %w", subnetID, err)
+	}
+
+	if v := tier["map_public_ip_on_launch"].(bool); v {
+		if err := modifySubnetMapPublicIPOnLaunch(ctx, conn, subnetID, v); err != nil {
+			return subnetID, err
+		}
+	}
+
+	return subnetID, nil
+}
+
+func deleteIPAMSubnet(ctx context.Context, conn *ec2.Client, subnetID string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Deleting EC2 Subnet: %s", subnetID)
+	if err := deleteLingeringENIs(ctx, conn, "subnet-id", subnetID, timeout); err != nil {
+		return fmt.Errorf("deleting ENIs for EC2 Subnet (%s): %w", subnetID, err)
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
+		return conn.DeleteSubnet(ctx, &ec2.DeleteSubnetInput{
+			SubnetId: aws.String(subnetID),
+		})
+	}, errCodeDependencyViolation)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidSubnetIDNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting EC2 Subnet (%s): %w", subnetID, err)
+	}
+
+	return nil
+}
+
+// ipamSubnetsLayout carves the specified IPv4 CIDR into one subnet per tier and Availability Zone.
+// Subnets are laid out tier by tier, in Availability Zone order, each aligned on its own size.
+func ipamSubnetsLayout(cidr string, netmaskLengths []int, azCount int) ([]string, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !prefix.Addr().Is4() {
+		return nil, fmt.Errorf("CIDR (%s) is not an IPv4 CIDR", cidr)
+	}
+
+	prefix = prefix.Masked()
+	start := uint64(ipv4ToUint32(prefix.Addr()))
+	end := start + uint64(1)<<(32-prefix.Bits())
+	next := start
+
+	var cidrBlocks []string
+	for _, netmaskLength := range netmaskLengths {
+		if netmaskLength < prefix.Bits() || netmaskLength > 32 {
+			return nil, fmt.Errorf("tier netmask length (%d) must be between %d and 32", netmaskLength, prefix.Bits())
+		}
+
+		size := uint64(1) << (32 - netmaskLength)
+
+		for range azCount {
+			next = (next + size - 1) &^ (size - 1)
+
+			if next+size > end {
+				return nil, fmt.Errorf("subnet layout does not fit in CIDR (%s)", cidr)
+			}
+
+			cidrBlocks = append(cidrBlocks, netip.PrefixFrom(uint32ToIPv4(uint32(next)), netmaskLength).String())
+			next += size
+		}
+	}
+
+	return cidrBlocks, nil
+}
+
+func ipv4ToUint32(addr netip.Addr) uint32 {
+	b := addr.As4()
+
+	return uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3])
+}
+
+func uint32ToIPv4(v uint32) netip.Addr {
+	return netip.AddrFrom4([4]byte{byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)})
+}
+
+func expandIPAMSubnetsTierNetmaskLengths(tfList []interface{}) []int {
+	var apiObjects []int
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, tfMap["netmask_length"].(int))
+	}
+
+	return apiObjects
+}
+
+func flattenIPAMSubnetsLayout(cidrBlocks []string, tiers []interface{}, azs []string) []interface{} {
+	tfList := make([]interface{}, 0, len(cidrBlocks))
+
+	for i, cidrBlock := range cidrBlocks {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrAvailabilityZone: azs[i%len(azs)],
+			names.AttrCIDRBlock:        cidrBlock,
+			"tier":                     tiers[i/len(azs)].(map[string]interface{})[names.AttrName],
+		})
+	}
+
+	return tfList
+}
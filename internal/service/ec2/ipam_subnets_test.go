@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestIPAMSubnetsLayout(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		cidr           string
+		netmaskLengths []int
+		azCount        int
+		expected       []string
+		expectError    bool
+	}{
+		"single tier": {
+			cidr:           "10.0.0.0/22",
+			netmaskLengths: []int{24},
+			azCount:        3,
+			expected:       []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.2.0/24"},
+		},
+		"multiple tiers": {
+			cidr:           "10.0.0.0/20",
+			netmaskLengths: []int{22, 24},
+			azCount:        2,
+			expected:       []string{"10.0.0.0/22", "10.0.4.0/22", "10.0.8.0/24", "10.0.9.0/24"},
+		},
+		"alignment": {
+			cidr:           "10.0.0.0/20",
+			netmaskLengths: []int{24, 22},
+			azCount:        2,
+			expected:       []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.4.0/22", "10.0.8.0/22"},
+		},
+		"does not fit": {
+			cidr:           "10.0.0.0/24",
+			netmaskLengths: []int{26, 26},
+			azCount:        3,
+			expectError:    true,
+		},
+		"tier larger than CIDR": {
+			cidr:           "10.0.0.0/24",
+			netmaskLengths: []int{23},
+			azCount:        1,
+			expectError:    true,
+		},
+		"IPv6": {
+			cidr:           "2001:db8::/56",
+			netmaskLengths: []int{64},
+			azCount:        1,
+			expectError:    true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfec2.IPAMSubnetsLayout(testCase.cidr, testCase.netmaskLengths, testCase.azCount)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("IPAMSubnetsLayout(%q) err %t, want %t", testCase.cidr, got, want)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(got, testCase.expected); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAccIPAMSubnets_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_ipam_subnets.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIPAMSubnetsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAMSubnetsConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAMSubnetsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cidr", "10.1.0.0/20"),
+					resource.TestMatchResourceAttr(resourceName, "ipam_pool_allocation_id", regexache.MustCompile(`^ipam-pool-alloc-[0-9a-f]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "subnet.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.0.cidr_block", "10.1.0.0/22"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.0.tier", "private"),
+					resource.TestCheckResourceAttrPair(resourceName, "subnet.0.availability_zone", "data.aws_availability_zones.available", "names.0"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.1.cidr_block", "10.1.4.0/22"),
+					resource.TestCheckResourceAttrPair(resourceName, "subnet.1.availability_zone", "data.aws_availability_zones.available", "names.1"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.2.cidr_block", "10.1.8.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.2.tier", "public"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.3.cidr_block", "10.1.9.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "4"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIPAMSubnets_tierTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_ipam_subnets.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIPAMSubnetsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAMSubnetsConfig_tierTags(acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAMSubnetsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tier.0.tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tier.0.tags.Tier", acctest.CtValue1),
+					testAccCheckIPAMSubnetsTag(ctx, resourceName, "Tier", acctest.CtValue1),
+				),
+			},
+			{
+				Config: testAccIPAMSubnetsConfig_tierTags(acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAMSubnetsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tier.0.tags.Tier", acctest.CtValue2),
+					testAccCheckIPAMSubnetsTag(ctx, resourceName, "Tier", acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIPAMSubnetsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		if _, err := tfec2.FindIPAMPoolAllocationByTwoPartKey(ctx, conn, rs.Primary.Attributes["ipam_pool_allocation_id"], rs.Primary.Attributes["ipam_pool_id"]); err != nil {
+			return err
+		}
+
+		for _, id := range testAccIPAMSubnetsSubnetIDs(rs) {
+			if _, err := tfec2.FindSubnetByID(ctx, conn, id); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIPAMSubnetsTag(ctx context.Context, n, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, id := range testAccIPAMSubnetsSubnetIDs(rs) {
+			subnet, err := tfec2.FindSubnetByID(ctx, conn, id)
+
+			if err != nil {
+				return err
+			}
+
+			var got string
+			for _, tag := range subnet.Tags {
+				if aws.ToString(tag.Key) == key {
+					got = aws.ToString(tag.Value)
+				}
+			}
+
+			if got != value {
+				return fmt.Errorf("EC2 Subnet (%s) tag %s = %q, want %q", id, key, got, value)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIPAMSubnetsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_vpc_ipam_subnets" {
+				continue
+			}
+
+			for _, id := range testAccIPAMSubnetsSubnetIDs(rs) {
+				_, err := tfec2.FindSubnetByID(ctx, conn, id)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("EC2 Subnet %s still exists", id)
+			}
+
+			_, err := tfec2.FindIPAMPoolAllocationByTwoPartKey(ctx, conn, rs.Primary.Attributes["ipam_pool_allocation_id"], rs.Primary.Attributes["ipam_pool_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IPAM Subnets still exists: %s", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccIPAMSubnetsSubnetIDs(rs *terraform.ResourceState) []string {
+	var ids []string
+
+	for i := 0; ; i++ {
+		id, ok := rs.Primary.Attributes[fmt.Sprintf("subnet_ids.%d", i)]
+		if !ok {
+			break
+		}
+
+		if id != "" {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+func testAccIPAMSubnetsConfig_base() string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), `
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+}
+
+resource "aws_vpc_ipam_pool" "test" {
+  address_family = "ipv4"
+  ipam_scope_id  = aws_vpc_ipam.test.private_default_scope_id
+  locale         = data.aws_region.current.name
+}
+
+resource "aws_vpc_ipam_pool_cidr" "test" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = "10.1.0.0/16"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+}
+`)
+}
+
+func testAccIPAMSubnetsConfig_basic() string {
+	return acctest.ConfigCompose(testAccIPAMSubnetsConfig_base(), `
+resource "aws_vpc_ipam_subnets" "test" {
+  ipam_pool_id       = aws_vpc_ipam_pool.test.id
+  netmask_length     = 20
+  vpc_id             = aws_vpc.test.id
+  availability_zones = slice(data.aws_availability_zones.available.names, 0, 2)
+
+  tier {
+    name           = "private"
+    netmask_length = 22
+  }
+
+  tier {
+    name                    = "public"
+    netmask_length          = 24
+    map_public_ip_on_launch = true
+  }
+
+  depends_on = [aws_vpc_ipam_pool_cidr.test]
+}
+`)
+}
+
+func testAccIPAMSubnetsConfig_tierTags(tagValue string) string {
+	return acctest.ConfigCompose(testAccIPAMSubnetsConfig_base(), fmt.Sprintf(`
+resource "aws_vpc_ipam_subnets" "test" {
+  ipam_pool_id       = aws_vpc_ipam_pool.test.id
+  netmask_length     = 22
+  vpc_id             = aws_vpc.test.id
+  availability_zones = slice(data.aws_availability_zones.available.names, 0, 2)
+
+  tier {
+    name           = "private"
+    netmask_length = 24
+
+    tags = {
+      Tier = %[1]q
+    }
+  }
+
+  depends_on = [aws_vpc_ipam_pool_cidr.test]
+}
+`, tagValue))
+}
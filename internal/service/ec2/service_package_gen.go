@@ -26,11 +26,19 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory: newSecurityGroupRulesDataSource,
 			Name:    "Security Group Rules",
 		},
+		{
+			Factory: newSecurityGroupVPCAssociationsDataSource,
+			Name:    "Security Group VPC Associations",
+		},
 	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newAMIRecycleBinRestoreResource,
+			Name:    "AMI Recycle Bin Restore",
+		},
 		{
 			Factory: newCapacityBlockReservationResource,
 			Name:    "Capacity Block Reservation",
@@ -38,6 +46,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory: newClientVPNAuthorizationRulesExclusiveResource,
+			Name:    "Client VPN Authorization Rules Exclusive",
+		},
+		{
+			Factory: newClientVPNRoutesExclusiveResource,
+			Name:    "Client VPN Routes Exclusive",
+		},
 		{
 			Factory: newEBSFastSnapshotRestoreResource,
 			Name:    "EBS Fast Snapshot Restore",
@@ -71,6 +87,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory: newSecurityGroupVPCAssociationResource,
+			Name:    "Security Group VPC Association",
+		},
 		{
 			Factory: newVPCEndpointPrivateDNSResource,
 			Name:    "VPC Endpoint Private DNS",
@@ -1305,6 +1325,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  resourceIPAMSubnets,
+			TypeName: "aws_vpc_ipam_subnets",
+			Name:     "IPAM Subnets",
+		},
 		{
 			Factory:  resourceVPCIPv4CIDRBlockAssociation,
 			TypeName: "aws_vpc_ipv4_cidr_block_association",
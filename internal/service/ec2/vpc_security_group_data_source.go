@@ -6,14 +6,18 @@ package ec2
 import (
 	"context"
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -56,6 +60,26 @@ func dataSourceSecurityGroup() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"vpc_associations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrState: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrVPCID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_owner_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -111,7 +135,41 @@ func dataSourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, me
 	d.Set(names.AttrName, sg.GroupName)
 	d.Set(names.AttrVPCID, sg.VpcId)
 
+	associations, err := findSecurityGroupVPCAssociationsBySecurityGroupID(ctx, conn, d.Id())
+
+	// Don't fail the read if VPC associations can't be described, e.g. missing IAM permission or an unsupported partition.
+	if tfawserr.ErrCodeEquals(err, errCodeAccessDenied, errCodeInvalidAction, errCodeUnauthorizedOperation, errCodeUnsupportedOperation) || errs.IsUnsupportedOperationInPartitionError(meta.(*conns.AWSClient).Partition, err) {
+		log.Printf("[WARN] Unable to read EC2 Security Group (%s) VPC associations: %s", d.Id(), err)
+		associations, err = nil, nil
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Security Group (%s) VPC associations: %s", d.Id(), err)
+	}
+
+	if err := d.Set("vpc_associations", flattenSecurityGroupVPCAssociations(associations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting vpc_associations: %s", err)
+	}
+
 	setTagsOut(ctx, sg.Tags)
 
 	return diags
 }
+
+func flattenSecurityGroupVPCAssociations(apiObjects []awstypes.SecurityGroupVpcAssociation) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrState: string(apiObject.State),
+			names.AttrVPCID: aws.ToString(apiObject.VpcId),
+			"vpc_owner_id":  aws.ToString(apiObject.VpcOwnerId),
+		})
+	}
+
+	return tfList
+}
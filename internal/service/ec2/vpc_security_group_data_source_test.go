@@ -88,3 +88,64 @@ data "aws_security_group" "by_filter" {
 }
 `, rName)
 }
+
+func TestAccVPCSecurityGroupDataSource_vpcAssociations(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_security_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupDataSourceConfig_vpcAssociations(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "vpc_associations.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "vpc_associations.0.state", "associated"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_associations.0.vpc_id", "aws_vpc.target", names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_associations.0.vpc_owner_id", "aws_vpc.target", names.AttrOwnerID),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCSecurityGroupDataSourceConfig_vpcAssociations(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "172.16.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "target" {
+  cidr_block = "172.17.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  vpc_id = aws_vpc.test.id
+  name   = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_security_group_vpc_association" "test" {
+  security_group_id = aws_security_group.test.id
+  vpc_id            = aws_vpc.target.id
+}
+
+data "aws_security_group" "test" {
+  id = aws_vpc_security_group_vpc_association.test.security_group_id
+}
+`, rName)
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// @FrameworkDataSource("aws_vpc_security_group_vpc_associations", name="Security Group VPC Associations")
+func newSecurityGroupVPCAssociationsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &securityGroupVPCAssociationsDataSource{}
+
+	return d, nil
+}
+
+type securityGroupVPCAssociationsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*securityGroupVPCAssociationsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_vpc_security_group_vpc_associations"
+}
+
+func (d *securityGroupVPCAssociationsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"associations": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[securityGroupVPCAssociationModel](ctx),
+				Computed:   true,
+			},
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *securityGroupVPCAssociationsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data securityGroupVPCAssociationsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().EC2Client(ctx)
+
+	output, err := findSecurityGroupVPCAssociationsBySecurityGroupID(ctx, conn, data.GroupID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Security Group (%s) VPC Associations", data.GroupID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.Associations)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type securityGroupVPCAssociationsDataSourceModel struct {
+	Associations fwtypes.ListNestedObjectValueOf[securityGroupVPCAssociationModel] `tfsdk:"associations"`
+	GroupID      types.String                                                      `tfsdk:"security_group_id"`
+}
+
+type securityGroupVPCAssociationModel struct {
+	State       fwtypes.StringEnum[awstypes.SecurityGroupVpcAssociationState] `tfsdk:"state"`
+	StateReason types.String                                                  `tfsdk:"state_reason"`
+	VPCID       types.String                                                  `tfsdk:"vpc_id"`
+	VPCOwnerID  types.String                                                  `tfsdk:"vpc_owner_id"`
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCSecurityGroupVPCAssociationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_vpc_security_group_vpc_associations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupVPCAssociationsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "associations.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "associations.0.state", string(awstypes.SecurityGroupVpcAssociationStateAssociated)),
+					resource.TestCheckResourceAttrPair(dataSourceName, "associations.0.vpc_id", "aws_vpc.target", names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "associations.0.vpc_owner_id", "aws_vpc.target", names.AttrOwnerID),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCSecurityGroupVPCAssociationsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupVPCAssociationConfig_basic(rName), `
+data "aws_vpc_security_group_vpc_associations" "test" {
+  security_group_id = aws_vpc_security_group_vpc_association.test.security_group_id
+}
+`)
+}
@@ -0,0 +1,313 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_ec2_client_vpn_authorization_rules_exclusive", name="Client VPN Authorization Rules Exclusive")
+func newClientVPNAuthorizationRulesExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &clientVPNAuthorizationRulesExclusiveResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type clientVPNAuthorizationRulesExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (*clientVPNAuthorizationRulesExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ec2_client_vpn_authorization_rules_exclusive"
+}
+
+func (r *clientVPNAuthorizationRulesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"client_vpn_endpoint_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"authorization_rule": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[clientVPNAuthorizationRuleModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"access_group_id": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("access_group_id"),
+									path.MatchRelative().AtParent().AtName("authorize_all_groups"),
+								),
+							},
+						},
+						"authorize_all_groups": schema.BoolAttribute{
+							Optional: true,
+							Validators: []validator.Bool{
+								fwvalidators.BoolEquals(true),
+							},
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Optional: true,
+						},
+						"target_network_cidr": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								fwvalidators.IPv4CIDRNetworkAddress(),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *clientVPNAuthorizationRulesExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data clientVPNAuthorizationRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	rules, diags := data.AuthorizationRules.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	endpointID := data.ClientVPNEndpointID.ValueString()
+
+	if err := r.syncAuthorizationRules(ctx, endpointID, rules, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating EC2 Client VPN Authorization Rules Exclusive (%s)", endpointID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *clientVPNAuthorizationRulesExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data clientVPNAuthorizationRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	endpointID := data.ClientVPNEndpointID.ValueString()
+	output, err := findClientVPNAuthorizationRulesByEndpointID(ctx, conn, endpointID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Client VPN Authorization Rules Exclusive (%s)", endpointID), err.Error())
+
+		return
+	}
+
+	rules, diags := fwtypes.NewSetNestedObjectValueOfValueSlice(ctx, tfslices.ApplyToAll(output, func(v awstypes.AuthorizationRule) clientVPNAuthorizationRuleModel {
+		return flattenClientVPNAuthorizationRuleModel(ctx, v)
+	}))
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.AuthorizationRules = rules
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *clientVPNAuthorizationRulesExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new clientVPNAuthorizationRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.AuthorizationRules.Equal(old.AuthorizationRules) {
+		rules, diags := new.AuthorizationRules.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		endpointID := new.ClientVPNEndpointID.ValueString()
+
+		if err := r.syncAuthorizationRules(ctx, endpointID, rules, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating EC2 Client VPN Authorization Rules Exclusive (%s)", endpointID), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *clientVPNAuthorizationRulesExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("client_vpn_endpoint_id"), request, response)
+}
+
+// syncAuthorizationRules makes the endpoint's authorization rules match the configured set.
+//
+// Rules that are configured but missing are authorized and rules that exist but are not
+// configured are revoked. As with routes, each call is started without waiting for the
+// previous one to become active, and all revocations complete before any authorization
+// starts so that a rule whose description changes can be replaced.
+func (r *clientVPNAuthorizationRulesExclusiveResource) syncAuthorizationRules(ctx context.Context, endpointID string, want []*clientVPNAuthorizationRuleModel, timeout time.Duration) error {
+	conn := r.Meta().EC2Client(ctx)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	output, err := findClientVPNAuthorizationRulesByEndpointID(ctx, conn, endpointID)
+
+	if err != nil {
+		return err
+	}
+
+	have := tfslices.ApplyToAll(output, func(v awstypes.AuthorizationRule) *clientVPNAuthorizationRuleModel {
+		rule := flattenClientVPNAuthorizationRuleModel(ctx, v)
+		return &rule
+	})
+
+	add, remove, _ := flex.DiffSlices(have, want, func(v1, v2 *clientVPNAuthorizationRuleModel) bool {
+		return v1.TargetNetworkCIDR.Equal(v2.TargetNetworkCIDR) &&
+			v1.AccessGroupID.ValueString() == v2.AccessGroupID.ValueString() &&
+			v1.AuthorizeAllGroups.ValueBool() == v2.AuthorizeAllGroups.ValueBool() &&
+			v1.Description.ValueString() == v2.Description.ValueString()
+	})
+
+	for _, v := range remove {
+		input := &ec2.RevokeClientVpnIngressInput{
+			ClientVpnEndpointId: aws.String(endpointID),
+			TargetNetworkCidr:   fwflex.StringFromFramework(ctx, v.TargetNetworkCIDR),
+		}
+
+		if v.AuthorizeAllGroups.ValueBool() {
+			input.RevokeAllGroups = aws.Bool(true)
+		} else {
+			input.AccessGroupId = fwflex.StringFromFramework(ctx, v.AccessGroupID)
+		}
+
+		_, err := conn.RevokeClientVpnIngress(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidClientVPNAuthorizationRuleNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("revoking authorization rule (%s): %w", v.TargetNetworkCIDR.ValueString(), err)
+		}
+	}
+
+	for _, v := range remove {
+		if _, err := waitClientVPNAuthorizationRuleDeleted(ctx, conn, endpointID, v.TargetNetworkCIDR.ValueString(), v.AccessGroupID.ValueString(), timeout); err != nil {
+			return fmt.Errorf("waiting for authorization rule (%s) revoke: %w", v.TargetNetworkCIDR.ValueString(), err)
+		}
+	}
+
+	for _, v := range add {
+		input := &ec2.AuthorizeClientVpnIngressInput{
+			AccessGroupId:       fwflex.StringFromFramework(ctx, v.AccessGroupID),
+			AuthorizeAllGroups:  fwflex.BoolFromFramework(ctx, v.AuthorizeAllGroups),
+			ClientToken:         aws.String(sdkid.UniqueId()),
+			ClientVpnEndpointId: aws.String(endpointID),
+			Description:         fwflex.StringFromFramework(ctx, v.Description),
+			TargetNetworkCidr:   fwflex.StringFromFramework(ctx, v.TargetNetworkCIDR),
+		}
+
+		if _, err := conn.AuthorizeClientVpnIngress(ctx, input); err != nil {
+			return fmt.Errorf("authorizing authorization rule (%s): %w", v.TargetNetworkCIDR.ValueString(), err)
+		}
+	}
+
+	for _, v := range add {
+		if _, err := waitClientVPNAuthorizationRuleCreated(ctx, conn, endpointID, v.TargetNetworkCIDR.ValueString(), v.AccessGroupID.ValueString(), timeout); err != nil {
+			return fmt.Errorf("waiting for authorization rule (%s) create: %w", v.TargetNetworkCIDR.ValueString(), err)
+		}
+	}
+
+	return nil
+}
+
+func flattenClientVPNAuthorizationRuleModel(ctx context.Context, apiObject awstypes.AuthorizationRule) clientVPNAuthorizationRuleModel {
+	rule := clientVPNAuthorizationRuleModel{
+		AccessGroupID:      types.StringNull(),
+		AuthorizeAllGroups: types.BoolNull(),
+		Description:        fwflex.StringToFramework(ctx, apiObject.Description),
+		TargetNetworkCIDR:  fwflex.StringToFramework(ctx, apiObject.DestinationCidr),
+	}
+
+	if aws.ToBool(apiObject.AccessAll) {
+		rule.AuthorizeAllGroups = types.BoolValue(true)
+	} else {
+		rule.AccessGroupID = fwflex.StringToFramework(ctx, apiObject.GroupId)
+	}
+
+	return rule
+}
+
+type clientVPNAuthorizationRulesExclusiveResourceModel struct {
+	AuthorizationRules  fwtypes.SetNestedObjectValueOf[clientVPNAuthorizationRuleModel] `tfsdk:"authorization_rule"`
+	ClientVPNEndpointID types.String                                                    `tfsdk:"client_vpn_endpoint_id"`
+	Timeouts            timeouts.Value                                                  `tfsdk:"timeouts"`
+}
+
+type clientVPNAuthorizationRuleModel struct {
+	AccessGroupID      types.String `tfsdk:"access_group_id"`
+	AuthorizeAllGroups types.Bool   `tfsdk:"authorize_all_groups"`
+	Description        types.String `tfsdk:"description"`
+	TargetNetworkCIDR  types.String `tfsdk:"target_network_cidr"`
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccClientVPNAuthorizationRulesExclusive_basic(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_client_vpn_authorization_rules_exclusive.test"
+	endpointResourceName := "aws_ec2_client_vpn_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckClientVPNSyncronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClientVPNEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClientVPNAuthorizationRulesExclusiveConfig_basic(t, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClientVPNAuthorizationRulesExclusiveCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttrPair(resourceName, "client_vpn_endpoint_id", endpointResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "authorization_rule.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "authorization_rule.*", map[string]string{
+						"target_network_cidr":  "10.1.0.0/24",
+						"authorize_all_groups": acctest.CtTrue,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "authorization_rule.*", map[string]string{
+						"target_network_cidr": "10.1.1.0/24",
+						"access_group_id":     "group-one",
+					}),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccClientVPNAuthorizationRulesExclusiveImportStateIDFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "client_vpn_endpoint_id",
+				ImportStateVerifyIgnore:              []string{names.AttrTimeouts},
+			},
+			{
+				Config: testAccClientVPNAuthorizationRulesExclusiveConfig_updated(t, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClientVPNAuthorizationRulesExclusiveCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "authorization_rule.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "authorization_rule.*", map[string]string{
+						"target_network_cidr": "10.1.1.0/24",
+						"access_group_id":     "group-one",
+						names.AttrDescription: "updated",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "authorization_rule.*", map[string]string{
+						"target_network_cidr": "10.1.2.0/24",
+						"access_group_id":     "group-two",
+					}),
+				),
+			},
+			{
+				Config: testAccClientVPNAuthorizationRulesExclusiveConfig_empty(t, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClientVPNAuthorizationRulesExclusiveCount(ctx, resourceName, 0),
+					resource.TestCheckResourceAttr(resourceName, "authorization_rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckClientVPNAuthorizationRulesExclusiveCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindClientVPNAuthorizationRulesByEndpointID(ctx, conn, rs.Primary.Attributes["client_vpn_endpoint_id"])
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("EC2 Client VPN Endpoint (%s) has %d authorization rules, want %d", rs.Primary.Attributes["client_vpn_endpoint_id"], got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccClientVPNAuthorizationRulesExclusiveImportStateIDFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.Attributes["client_vpn_endpoint_id"], nil
+	}
+}
+
+func testAccClientVPNAuthorizationRulesExclusiveConfig_basic(t *testing.T, rName string) string {
+	return acctest.ConfigCompose(testAccClientVPNEndpointConfig_basic(t, rName), `
+resource "aws_ec2_client_vpn_authorization_rules_exclusive" "test" {
+  client_vpn_endpoint_id = aws_ec2_client_vpn_endpoint.test.id
+
+  authorization_rule {
+    target_network_cidr  = "10.1.0.0/24"
+    authorize_all_groups = true
+  }
+
+  authorization_rule {
+    target_network_cidr = "10.1.1.0/24"
+    access_group_id     = "group-one"
+  }
+}
+`)
+}
+
+func testAccClientVPNAuthorizationRulesExclusiveConfig_updated(t *testing.T, rName string) string {
+	return acctest.ConfigCompose(testAccClientVPNEndpointConfig_basic(t, rName), `
+resource "aws_ec2_client_vpn_authorization_rules_exclusive" "test" {
+  client_vpn_endpoint_id = aws_ec2_client_vpn_endpoint.test.id
+
+  authorization_rule {
+    target_network_cidr = "10.1.1.0/24"
+    access_group_id     = "group-one"
+    description         = "updated"
+  }
+
+  authorization_rule {
+    target_network_cidr = "10.1.2.0/24"
+    access_group_id     = "group-two"
+  }
+}
+`)
+}
+
+func testAccClientVPNAuthorizationRulesExclusiveConfig_empty(t *testing.T, rName string) string {
+	return acctest.ConfigCompose(testAccClientVPNEndpointConfig_basic(t, rName), `
+resource "aws_ec2_client_vpn_authorization_rules_exclusive" "test" {
+  client_vpn_endpoint_id = aws_ec2_client_vpn_endpoint.test.id
+}
+`)
+}
@@ -0,0 +1,287 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_ec2_client_vpn_routes_exclusive", name="Client VPN Routes Exclusive")
+func newClientVPNRoutesExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &clientVPNRoutesExclusiveResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type clientVPNRoutesExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (*clientVPNRoutesExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ec2_client_vpn_routes_exclusive"
+}
+
+func (r *clientVPNRoutesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"client_vpn_endpoint_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"route": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[clientVPNRouteModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrDescription: schema.StringAttribute{
+							Optional: true,
+						},
+						"destination_cidr_block": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								fwvalidators.IPv4CIDRNetworkAddress(),
+							},
+						},
+						"target_vpc_subnet_id": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *clientVPNRoutesExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data clientVPNRoutesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	routes, diags := data.Routes.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	endpointID := data.ClientVPNEndpointID.ValueString()
+
+	if err := r.syncRoutes(ctx, endpointID, routes, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating EC2 Client VPN Routes Exclusive (%s)", endpointID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *clientVPNRoutesExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data clientVPNRoutesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	endpointID := data.ClientVPNEndpointID.ValueString()
+	output, err := findClientVPNAddedRoutesByEndpointID(ctx, conn, endpointID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Client VPN Routes Exclusive (%s)", endpointID), err.Error())
+
+		return
+	}
+
+	routes, diags := fwtypes.NewSetNestedObjectValueOfValueSlice(ctx, tfslices.ApplyToAll(output, func(v awstypes.ClientVpnRoute) clientVPNRouteModel {
+		return clientVPNRouteModel{
+			Description:          fwflex.StringToFramework(ctx, v.Description),
+			DestinationCIDRBlock: fwflex.StringToFramework(ctx, v.DestinationCidr),
+			TargetVPCSubnetID:    fwflex.StringToFramework(ctx, v.TargetSubnet),
+		}
+	}))
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Routes = routes
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *clientVPNRoutesExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new clientVPNRoutesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.Routes.Equal(old.Routes) {
+		routes, diags := new.Routes.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		endpointID := new.ClientVPNEndpointID.ValueString()
+
+		if err := r.syncRoutes(ctx, endpointID, routes, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating EC2 Client VPN Routes Exclusive (%s)", endpointID), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *clientVPNRoutesExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("client_vpn_endpoint_id"), request, response)
+}
+
+// syncRoutes makes the endpoint's manually added routes match the configured set.
+//
+// Routes that are configured but missing are created and routes that exist but are
+// not configured are deleted. Routes created by associating a target network are left alone.
+// Each mutation is started without waiting for the previous one to finish so that the
+// endpoint processes them together; the service rejects a mutation while the endpoint is
+// busy with ConcurrentMutationLimitExceeded, which the EC2 client retries.
+// A route whose description changes is replaced, and all deletions complete before any creation
+// starts so that a replaced route never collides with itself.
+func (r *clientVPNRoutesExclusiveResource) syncRoutes(ctx context.Context, endpointID string, want []*clientVPNRouteModel, timeout time.Duration) error {
+	conn := r.Meta().EC2Client(ctx)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	output, err := findClientVPNAddedRoutesByEndpointID(ctx, conn, endpointID)
+
+	if err != nil {
+		return err
+	}
+
+	have := tfslices.ApplyToAll(output, func(v awstypes.ClientVpnRoute) *clientVPNRouteModel {
+		return &clientVPNRouteModel{
+			Description:          fwflex.StringToFramework(ctx, v.Description),
+			DestinationCIDRBlock: fwflex.StringToFramework(ctx, v.DestinationCidr),
+			TargetVPCSubnetID:    fwflex.StringToFramework(ctx, v.TargetSubnet),
+		}
+	})
+
+	add, remove, _ := flex.DiffSlices(have, want, func(v1, v2 *clientVPNRouteModel) bool {
+		return v1.DestinationCIDRBlock.Equal(v2.DestinationCIDRBlock) && v1.TargetVPCSubnetID.Equal(v2.TargetVPCSubnetID) && v1.Description.ValueString() == v2.Description.ValueString()
+	})
+
+	for _, v := range remove {
+		input := &ec2.DeleteClientVpnRouteInput{
+			ClientVpnEndpointId:  aws.String(endpointID),
+			DestinationCidrBlock: fwflex.StringFromFramework(ctx, v.DestinationCIDRBlock),
+			TargetVpcSubnetId:    fwflex.StringFromFramework(ctx, v.TargetVPCSubnetID),
+		}
+
+		_, err := conn.DeleteClientVpnRoute(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidClientVPNRouteNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting route (%s): %w", v.DestinationCIDRBlock.ValueString(), err)
+		}
+	}
+
+	for _, v := range remove {
+		if _, err := waitClientVPNRouteDeleted(ctx, conn, endpointID, v.TargetVPCSubnetID.ValueString(), v.DestinationCIDRBlock.ValueString(), timeout); err != nil {
+			return fmt.Errorf("waiting for route (%s) delete: %w", v.DestinationCIDRBlock.ValueString(), err)
+		}
+	}
+
+	for _, v := range add {
+		input := &ec2.CreateClientVpnRouteInput{
+			ClientToken:          aws.String(sdkid.UniqueId()),
+			ClientVpnEndpointId:  aws.String(endpointID),
+			Description:          fwflex.StringFromFramework(ctx, v.Description),
+			DestinationCidrBlock: fwflex.StringFromFramework(ctx, v.DestinationCIDRBlock),
+			TargetVpcSubnetId:    fwflex.StringFromFramework(ctx, v.TargetVPCSubnetID),
+		}
+
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, ec2PropagationTimeout, func() (interface{}, error) {
+			return conn.CreateClientVpnRoute(ctx, input)
+		}, errCodeInvalidClientVPNActiveAssociationNotFound)
+
+		if err != nil {
+			return fmt.Errorf("creating route (%s): %w", v.DestinationCIDRBlock.ValueString(), err)
+		}
+	}
+
+	for _, v := range add {
+		if _, err := waitClientVPNRouteCreated(ctx, conn, endpointID, v.TargetVPCSubnetID.ValueString(), v.DestinationCIDRBlock.ValueString(), timeout); err != nil {
+			return fmt.Errorf("waiting for route (%s) create: %w", v.DestinationCIDRBlock.ValueString(), err)
+		}
+	}
+
+	return nil
+}
+
+type clientVPNRoutesExclusiveResourceModel struct {
+	ClientVPNEndpointID types.String                                        `tfsdk:"client_vpn_endpoint_id"`
+	Routes              fwtypes.SetNestedObjectValueOf[clientVPNRouteModel] `tfsdk:"route"`
+	Timeouts            timeouts.Value                                      `tfsdk:"timeouts"`
+}
+
+type clientVPNRouteModel struct {
+	Description          types.String `tfsdk:"description"`
+	DestinationCIDRBlock types.String `tfsdk:"destination_cidr_block"`
+	TargetVPCSubnetID    types.String `tfsdk:"target_vpc_subnet_id"`
+}
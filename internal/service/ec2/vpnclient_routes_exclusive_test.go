@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccClientVPNRoutesExclusive_basic(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_client_vpn_routes_exclusive.test"
+	endpointResourceName := "aws_ec2_client_vpn_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckClientVPNSyncronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClientVPNEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClientVPNRoutesExclusiveConfig_basic(t, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClientVPNRoutesExclusiveCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttrPair(resourceName, "client_vpn_endpoint_id", endpointResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"destination_cidr_block": "10.2.0.0/16",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"destination_cidr_block": "10.3.0.0/16",
+					}),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccClientVPNRoutesExclusiveImportStateIDFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "client_vpn_endpoint_id",
+				ImportStateVerifyIgnore:              []string{names.AttrTimeouts},
+			},
+			{
+				Config: testAccClientVPNRoutesExclusiveConfig_updated(t, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClientVPNRoutesExclusiveCount(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"destination_cidr_block": "10.3.0.0/16",
+						names.AttrDescription:    "updated",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"destination_cidr_block": "10.5.0.0/16",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccClientVPNRoutesExclusive_outOfBandAddition(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_client_vpn_routes_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckClientVPNSyncronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClientVPNEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClientVPNRoutesExclusiveConfig_single(t, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClientVPNRoutesExclusiveCount(ctx, resourceName, 1),
+					testAccCheckClientVPNRoutesExclusiveAddRoute(ctx, resourceName, "10.9.0.0/16"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccClientVPNRoutesExclusiveConfig_single(t, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClientVPNRoutesExclusiveCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckClientVPNRoutesExclusiveCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindClientVPNAddedRoutesByEndpointID(ctx, conn, rs.Primary.Attributes["client_vpn_endpoint_id"])
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("EC2 Client VPN Endpoint (%s) has %d added routes, want %d", rs.Primary.Attributes["client_vpn_endpoint_id"], got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckClientVPNRoutesExclusiveAddRoute(ctx context.Context, n, destinationCIDR string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		endpointID := rs.Primary.Attributes["client_vpn_endpoint_id"]
+		targetSubnetID := rs.Primary.Attributes["route.0.target_vpc_subnet_id"]
+
+		_, err := conn.CreateClientVpnRoute(ctx, &ec2.CreateClientVpnRouteInput{
+			ClientVpnEndpointId:  aws.String(endpointID),
+			DestinationCidrBlock: aws.String(destinationCIDR),
+			TargetVpcSubnetId:    aws.String(targetSubnetID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfec2.WaitClientVPNRouteCreated(ctx, conn, endpointID, targetSubnetID, destinationCIDR, 4*time.Minute)
+
+		return err
+	}
+}
+
+func testAccClientVPNRoutesExclusiveImportStateIDFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.Attributes["client_vpn_endpoint_id"], nil
+	}
+}
+
+func testAccClientVPNRoutesExclusiveConfig_base(t *testing.T, rName string) string {
+	return acctest.ConfigCompose(testAccClientVPNRouteConfig_base(t, rName, 1), `
+resource "aws_ec2_client_vpn_network_association" "test" {
+  client_vpn_endpoint_id = aws_ec2_client_vpn_endpoint.test.id
+  subnet_id              = aws_subnet.test[0].id
+}
+`)
+}
+
+func testAccClientVPNRoutesExclusiveConfig_single(t *testing.T, rName string) string {
+	return acctest.ConfigCompose(testAccClientVPNRoutesExclusiveConfig_base(t, rName), `
+resource "aws_ec2_client_vpn_routes_exclusive" "test" {
+  client_vpn_endpoint_id = aws_ec2_client_vpn_network_association.test.client_vpn_endpoint_id
+
+  route {
+    destination_cidr_block = "10.2.0.0/16"
+    target_vpc_subnet_id   = aws_subnet.test[0].id
+  }
+}
+`)
+}
+
+func testAccClientVPNRoutesExclusiveConfig_basic(t *testing.T, rName string) string {
+	return acctest.ConfigCompose(testAccClientVPNRoutesExclusiveConfig_base(t, rName), `
+resource "aws_ec2_client_vpn_routes_exclusive" "test" {
+  client_vpn_endpoint_id = aws_ec2_client_vpn_network_association.test.client_vpn_endpoint_id
+
+  route {
+    destination_cidr_block = "10.2.0.0/16"
+    target_vpc_subnet_id   = aws_subnet.test[0].id
+  }
+
+  route {
+    destination_cidr_block = "10.3.0.0/16"
+    target_vpc_subnet_id   = aws_subnet.test[0].id
+  }
+}
+`)
+}
+
+func testAccClientVPNRoutesExclusiveConfig_updated(t *testing.T, rName string) string {
+	return acctest.ConfigCompose(testAccClientVPNRoutesExclusiveConfig_base(t, rName), `
+resource "aws_ec2_client_vpn_routes_exclusive" "test" {
+  client_vpn_endpoint_id = aws_ec2_client_vpn_network_association.test.client_vpn_endpoint_id
+
+  route {
+    destination_cidr_block = "10.3.0.0/16"
+    target_vpc_subnet_id   = aws_subnet.test[0].id
+    description            = "updated"
+  }
+
+  route {
+    destination_cidr_block = "10.4.0.0/16"
+    target_vpc_subnet_id   = aws_subnet.test[0].id
+  }
+
+  route {
+    destination_cidr_block = "10.5.0.0/16"
+    target_vpc_subnet_id   = aws_subnet.test[0].id
+  }
+}
+`)
+}
@@ -45,6 +45,9 @@ func TestAccClientVPNEndpoint_serial(t *testing.T) {
 			acctest.CtDisappears: testAccClientVPNAuthorizationRule_disappears,
 			"disappearsEndpoint": testAccClientVPNAuthorizationRule_Disappears_endpoint,
 		},
+		"AuthorizationRulesExclusive": {
+			acctest.CtBasic: testAccClientVPNAuthorizationRulesExclusive_basic,
+		},
 		"NetworkAssociation": {
 			acctest.CtBasic:      testAccClientVPNNetworkAssociation_basic,
 			"multipleSubnets":    testAccClientVPNNetworkAssociation_multipleSubnets,
@@ -55,6 +58,10 @@ func TestAccClientVPNEndpoint_serial(t *testing.T) {
 			"description":        testAccClientVPNRoute_description,
 			acctest.CtDisappears: testAccClientVPNRoute_disappears,
 		},
+		"RoutesExclusive": {
+			acctest.CtBasic:     testAccClientVPNRoutesExclusive_basic,
+			"outOfBandAddition": testAccClientVPNRoutesExclusive_outOfBandAddition,
+		},
 	}
 
 	acctest.RunLimitedConcurrencyTests2Levels(t, semaphore, testCases)
@@ -594,6 +594,52 @@ func waitImageAvailable(ctx context.Context, conn *ec2.Client, id string, timeou
 	return nil, err
 }
 
+func waitImageDisabled(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Image, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.ImageStateAvailable),
+		Target:     enum.Slice(awstypes.ImageStateDisabled),
+		Refresh:    statusImage(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      amiRetryDelay,
+		MinTimeout: amiRetryMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Image); ok {
+		if stateReason := output.StateReason; stateReason != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(stateReason.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitImageEnabled(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Image, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.ImageStateDisabled, awstypes.ImageStatePending),
+		Target:     enum.Slice(awstypes.ImageStateAvailable),
+		Refresh:    statusImage(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      amiRetryDelay,
+		MinTimeout: amiRetryMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Image); ok {
+		if stateReason := output.StateReason; stateReason != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(stateReason.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitImageBlockPublicAccessState(ctx context.Context, conn *ec2.Client, target string, timeout time.Duration) error {
 	stateConf := &retry.StateChangeConf{
 		Target:     []string{target},
@@ -610,7 +656,7 @@ func waitImageBlockPublicAccessState(ctx context.Context, conn *ec2.Client, targ
 
 func waitImageDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Image, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending:    enum.Slice(awstypes.ImageStateAvailable, awstypes.ImageStateFailed, awstypes.ImageStatePending),
+		Pending:    enum.Slice(awstypes.ImageStateAvailable, awstypes.ImageStateDisabled, awstypes.ImageStateFailed, awstypes.ImageStatePending),
 		Target:     []string{},
 		Refresh:    statusImage(ctx, conn, id),
 		Timeout:    timeout,
@@ -760,6 +806,25 @@ func waitInstanceRootBlockDeviceDeleteOnTerminationUpdated(ctx context.Context,
 	return nil, err
 }
 
+func waitReplaceRootVolumeTaskSucceeded(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.ReplaceRootVolumeTask, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.ReplaceRootVolumeTaskStatePending, awstypes.ReplaceRootVolumeTaskStateInProgress, awstypes.ReplaceRootVolumeTaskStateFailing),
+		Target:     enum.Slice(awstypes.ReplaceRootVolumeTaskStateSucceeded),
+		Refresh:    statusReplaceRootVolumeTask(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ReplaceRootVolumeTask); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitInternetGatewayAttached(ctx context.Context, conn *ec2.Client, internetGatewayID, vpcID string, timeout time.Duration) (*awstypes.InternetGatewayAttachment, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:        enum.Slice(awstypes.AttachmentStatusAttaching),
@@ -1642,6 +1707,44 @@ func waitSecurityGroupCreated(ctx context.Context, conn *ec2.Client, id string,
 	return nil, err
 }
 
+func waitSecurityGroupVPCAssociationCreated(ctx context.Context, conn *ec2.Client, groupID, vpcID string, timeout time.Duration) (*awstypes.SecurityGroupVpcAssociation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SecurityGroupVpcAssociationStateAssociating),
+		Target:  enum.Slice(awstypes.SecurityGroupVpcAssociationStateAssociated),
+		Refresh: statusSecurityGroupVPCAssociation(ctx, conn, groupID, vpcID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.SecurityGroupVpcAssociation); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSecurityGroupVPCAssociationDeleted(ctx context.Context, conn *ec2.Client, groupID, vpcID string, timeout time.Duration) (*awstypes.SecurityGroupVpcAssociation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SecurityGroupVpcAssociationStateAssociated, awstypes.SecurityGroupVpcAssociationStateDisassociating),
+		Target:  []string{},
+		Refresh: statusSecurityGroupVPCAssociation(ctx, conn, groupID, vpcID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.SecurityGroupVpcAssociation); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitSpotFleetRequestCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.SpotFleetRequestConfig, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    enum.Slice(awstypes.BatchStateSubmitted),
@@ -8,5 +8,6 @@ import (
 )
 
 const (
-	propagationTimeout = 2 * time.Minute
+	lifecyclePolicyPreviewTimeout = 10 * time.Minute
+	propagationTimeout            = 2 * time.Minute
 )
@@ -0,0 +1,385 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ecr_image_scan_findings", name="Image Scan Findings")
+func dataSourceImageScanFindings() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceImageScanFindingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"enhanced_findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"exploit_available": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finding_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"first_observed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fix_available": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_observed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"remediation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"score": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vulnerability_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vulnerable_packages": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fixed_in_version": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"package_manager": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrVersion: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"finding_severity_counts": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAttributes: {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrURI: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"image_digest": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"image_digest", "image_tag"},
+			},
+			"image_scan_completed_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_tag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"image_digest", "image_tag"},
+			},
+			"registry_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			names.AttrRepositoryName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"scan_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scan_status_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vulnerability_source_updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceImageScanFindingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRClient(ctx)
+
+	repositoryName := d.Get(names.AttrRepositoryName).(string)
+	input := &ecr.DescribeImageScanFindingsInput{
+		ImageId:        &types.ImageIdentifier{},
+		RepositoryName: aws.String(repositoryName),
+	}
+
+	if v, ok := d.GetOk("image_digest"); ok {
+		input.ImageId.ImageDigest = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("image_tag"); ok {
+		input.ImageId.ImageTag = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("registry_id"); ok {
+		input.RegistryId = aws.String(v.(string))
+	}
+
+	output, err := findImageScanFindings(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECR Image Scan Findings (%s): %s", repositoryName, err)
+	}
+
+	// Findings are only complete once the scan is. Anything else would look like an image without vulnerabilities.
+	if v := output.ImageScanStatus; v != nil {
+		switch status := v.Status; status {
+		case types.ScanStatusActive, types.ScanStatusComplete:
+		default:
+			return sdkdiag.AppendErrorf(diags, "reading ECR Image Scan Findings (%s): scan status is %s: %s", repositoryName, status, aws.ToString(v.Description))
+		}
+	}
+
+	d.SetId(aws.ToString(output.ImageId.ImageDigest))
+	d.Set("image_digest", output.ImageId.ImageDigest)
+	d.Set("registry_id", output.RegistryId)
+	d.Set(names.AttrRepositoryName, output.RepositoryName)
+	if v := output.ImageScanStatus; v != nil {
+		d.Set("scan_status", v.Status)
+		d.Set("scan_status_description", v.Description)
+	}
+	if v := output.ImageScanFindings; v != nil {
+		if err := d.Set("enhanced_findings", flattenEnhancedImageScanFindings(v.EnhancedFindings)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting enhanced_findings: %s", err)
+		}
+		d.Set("finding_severity_counts", flattenFindingSeverityCounts(v.FindingSeverityCounts))
+		if err := d.Set("findings", flattenImageScanFindings(v.Findings)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting findings: %s", err)
+		}
+		if v := v.ImageScanCompletedAt; v != nil {
+			d.Set("image_scan_completed_at", aws.ToTime(v).Format(time.RFC3339))
+		}
+		if v := v.VulnerabilitySourceUpdatedAt; v != nil {
+			d.Set("vulnerability_source_updated_at", aws.ToTime(v).Format(time.RFC3339))
+		}
+	}
+
+	return diags
+}
+
+// findImageScanFindings returns the first page of output with the findings from all pages merged into it.
+func findImageScanFindings(ctx context.Context, conn *ecr.Client, input *ecr.DescribeImageScanFindingsInput) (*ecr.DescribeImageScanFindingsOutput, error) {
+	var output *ecr.DescribeImageScanFindingsOutput
+
+	pages := ecr.NewDescribeImageScanFindingsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ImageNotFoundException](err) || errs.IsA[*types.RepositoryNotFoundException](err) || errs.IsA[*types.ScanNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			output = page
+			continue
+		}
+
+		if v := page.ImageScanFindings; v != nil {
+			if output.ImageScanFindings == nil {
+				output.ImageScanFindings = &types.ImageScanFindings{}
+			}
+			output.ImageScanFindings.EnhancedFindings = append(output.ImageScanFindings.EnhancedFindings, v.EnhancedFindings...)
+			output.ImageScanFindings.Findings = append(output.ImageScanFindings.Findings, v.Findings...)
+		}
+	}
+
+	if output == nil || output.ImageId == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func flattenFindingSeverityCounts(apiObject map[string]int32) map[string]interface{} {
+	tfMap := make(map[string]interface{}, len(apiObject))
+
+	for k, v := range apiObject {
+		tfMap[k] = int(v)
+	}
+
+	return tfMap
+}
+
+func flattenImageScanFindings(apiObjects []types.ImageScanFinding) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		attributes := make(map[string]interface{}, len(apiObject.Attributes))
+		for _, v := range apiObject.Attributes {
+			attributes[aws.ToString(v.Key)] = aws.ToString(v.Value)
+		}
+
+		tfMap := map[string]interface{}{
+			names.AttrAttributes:  attributes,
+			names.AttrDescription: aws.ToString(apiObject.Description),
+			names.AttrName:        aws.ToString(apiObject.Name),
+			"severity":            apiObject.Severity,
+			names.AttrURI:         aws.ToString(apiObject.Uri),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenEnhancedImageScanFindings(apiObjects []types.EnhancedImageScanFinding) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrDescription: aws.ToString(apiObject.Description),
+			"exploit_available":   aws.ToString(apiObject.ExploitAvailable),
+			"finding_arn":         aws.ToString(apiObject.FindingArn),
+			"fix_available":       aws.ToString(apiObject.FixAvailable),
+			"score":               apiObject.Score,
+			"severity":            aws.ToString(apiObject.Severity),
+			names.AttrStatus:      aws.ToString(apiObject.Status),
+			"title":               aws.ToString(apiObject.Title),
+			names.AttrType:        aws.ToString(apiObject.Type),
+		}
+
+		if v := apiObject.FirstObservedAt; v != nil {
+			tfMap["first_observed_at"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.LastObservedAt; v != nil {
+			tfMap["last_observed_at"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.PackageVulnerabilityDetails; v != nil {
+			tfMap["source_url"] = aws.ToString(v.SourceUrl)
+			tfMap["vulnerability_id"] = aws.ToString(v.VulnerabilityId)
+			tfMap["vulnerable_packages"] = flattenVulnerablePackages(v.VulnerablePackages)
+		}
+
+		if v := apiObject.Remediation; v != nil && v.Recommendation != nil {
+			tfMap["remediation"] = aws.ToString(v.Recommendation.Text)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenVulnerablePackages(apiObjects []types.VulnerablePackage) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"fixed_in_version": aws.ToString(apiObject.FixedInVersion),
+			names.AttrName:     aws.ToString(apiObject.Name),
+			"package_manager":  aws.ToString(apiObject.PackageManager),
+			names.AttrVersion:  aws.ToString(apiObject.Version),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
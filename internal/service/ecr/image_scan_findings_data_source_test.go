@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECRImageScanFindingsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	// The repository must contain a scanned image tagged "latest".
+	repo := acctest.SkipIfEnvVarNotSet(t, "ECR_IMAGE_SCAN_FINDINGS_REPOSITORY_NAME")
+	dataSourceName := "data.aws_ecr_image_scan_findings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageScanFindingsDataSourceConfig_basic(repo, "latest"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "image_digest", "data.aws_ecr_image.test", "image_digest"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "image_scan_completed_at"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "registry_id"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrRepositoryName, repo),
+					resource.TestCheckResourceAttr(dataSourceName, "scan_status", "COMPLETE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccImageScanFindingsDataSourceConfig_basic(repo, tag string) string {
+	return fmt.Sprintf(`
+data "aws_ecr_image" "test" {
+  repository_name = %[1]q
+  image_tag       = %[2]q
+}
+
+data "aws_ecr_image_scan_findings" "test" {
+  repository_name = %[1]q
+  image_digest    = data.aws_ecr_image.test.image_digest
+}
+`, repo, tag)
+}
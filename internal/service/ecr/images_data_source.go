@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ecr_images", name="Images")
+func dataSourceImages() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceImagesRead,
+
+		Schema: map[string]*schema.Schema{
+			"images": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"image_digest": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"image_pushed_at": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"image_size_in_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"image_tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"image_uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"max_age_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"registry_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			names.AttrRepositoryName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tag_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"tag_status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.TagStatus](),
+			},
+		},
+	}
+}
+
+func dataSourceImagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRClient(ctx)
+
+	repositoryName := d.Get(names.AttrRepositoryName).(string)
+	input := &ecr.DescribeImagesInput{
+		RepositoryName: aws.String(repositoryName),
+	}
+
+	if v, ok := d.GetOk("registry_id"); ok {
+		input.RegistryId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tag_status"); ok {
+		input.Filter = &types.DescribeImagesFilter{
+			TagStatus: types.TagStatus(v.(string)),
+		}
+	}
+
+	imageDetails, err := findImageDetails(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECR Images (%s): %s", repositoryName, err)
+	}
+
+	if v, ok := d.GetOk("tag_regex"); ok {
+		r := regexache.MustCompile(v.(string))
+		imageDetails = tfslices.Filter(imageDetails, func(v types.ImageDetail) bool {
+			return slices.ContainsFunc(v.ImageTags, r.MatchString)
+		})
+	}
+
+	if v, ok := d.GetOk("max_age_in_days"); ok {
+		pushedAfter := time.Now().AddDate(0, 0, -v.(int))
+		imageDetails = tfslices.Filter(imageDetails, func(v types.ImageDetail) bool {
+			return aws.ToTime(v.ImagePushedAt).After(pushedAfter)
+		})
+	}
+
+	// Most recently pushed first.
+	slices.SortStableFunc(imageDetails, func(a, b types.ImageDetail) int {
+		return aws.ToTime(b.ImagePushedAt).Compare(aws.ToTime(a.ImagePushedAt))
+	})
+
+	repositoryInput := &ecr.DescribeRepositoriesInput{
+		RegistryId:      input.RegistryId,
+		RepositoryNames: []string{repositoryName},
+	}
+
+	repository, err := findRepository(ctx, conn, repositoryInput)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECR Repository (%s): %s", repositoryName, err)
+	}
+
+	repositoryURI := aws.ToString(repository.RepositoryUri)
+
+	d.SetId(repositoryName)
+	if err := d.Set("images", flattenImageDetails(imageDetails, repositoryURI)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting images: %s", err)
+	}
+	d.Set("registry_id", repository.RegistryId)
+	d.Set(names.AttrRepositoryName, repositoryName)
+
+	return diags
+}
+
+func flattenImageDetails(apiObjects []types.ImageDetail, repositoryURI string) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"image_digest":        aws.ToString(apiObject.ImageDigest),
+			"image_pushed_at":     aws.ToTime(apiObject.ImagePushedAt).Unix(),
+			"image_size_in_bytes": aws.ToInt64(apiObject.ImageSizeInBytes),
+			"image_tags":          apiObject.ImageTags,
+			"image_uri":           fmt.Sprintf("%s@%s", repositoryURI, aws.ToString(apiObject.ImageDigest)),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECRImagesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	registry, repo := "137112412989", "amazonlinux"
+	dataSourceName := "data.aws_ecr_images.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesDataSourceConfig_basic(registry, repo),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "images.#", 0),
+					resource.TestCheckResourceAttrSet(dataSourceName, "images.0.image_digest"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "images.0.image_pushed_at"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "images.0.image_size_in_bytes"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "images.0.image_uri"),
+					resource.TestCheckResourceAttr(dataSourceName, "registry_id", registry),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrRepositoryName, repo),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECRImagesDataSource_tagRegex(t *testing.T) {
+	ctx := acctest.Context(t)
+	registry, repo, tag := "137112412989", "amazonlinux", "latest"
+	dataSourceName := "data.aws_ecr_images.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesDataSourceConfig_tagRegex(registry, repo, tag),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "images.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "images.0.image_tags.*", tag),
+					resource.TestCheckResourceAttrPair(dataSourceName, "images.0.image_digest", "data.aws_ecr_image.test", "image_digest"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECRImagesDataSource_empty(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ecr_images.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesDataSourceConfig_empty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "images.#", acctest.Ct0),
+					resource.TestCheckResourceAttrPair(dataSourceName, "registry_id", "aws_ecr_repository.test", "registry_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccImagesDataSourceConfig_basic(reg, repo string) string {
+	return fmt.Sprintf(`
+data "aws_ecr_images" "test" {
+  registry_id     = %[1]q
+  repository_name = %[2]q
+  tag_status      = "TAGGED"
+}
+`, reg, repo)
+}
+
+func testAccImagesDataSourceConfig_tagRegex(reg, repo, tag string) string {
+	return fmt.Sprintf(`
+data "aws_ecr_images" "test" {
+  registry_id     = %[1]q
+  repository_name = %[2]q
+  tag_regex       = "^%[3]s$"
+}
+
+data "aws_ecr_image" "test" {
+  registry_id     = %[1]q
+  repository_name = %[2]q
+  image_tag       = %[3]q
+}
+`, reg, repo, tag)
+}
+
+func testAccImagesDataSourceConfig_empty(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name = %[1]q
+}
+
+data "aws_ecr_images" "test" {
+  repository_name = aws_ecr_repository.test.name
+  max_age_in_days = 7
+}
+`, rName)
+}
@@ -5,6 +5,7 @@ package ecr
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"sort"
 	"strings"
@@ -30,12 +31,15 @@ func resourceLifecyclePolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLifecyclePolicyCreate,
 		ReadWithoutTimeout:   resourceLifecyclePolicyRead,
+		UpdateWithoutTimeout: resourceLifecyclePolicyUpdate,
 		DeleteWithoutTimeout: resourceLifecyclePolicyDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: lifecyclePolicyPreviewGuardCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrPolicy: {
 				Type:         schema.TypeString,
@@ -52,6 +56,20 @@ func resourceLifecyclePolicy() *schema.Resource {
 					return json
 				},
 			},
+			"preview_guard": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_expiring_images": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
 			"registry_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -128,6 +146,14 @@ func resourceLifecyclePolicyRead(ctx context.Context, d *schema.ResourceData, me
 	return diags
 }
 
+func resourceLifecyclePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Only preview_guard can be updated in-place and it is not sent to the API.
+
+	return append(diags, resourceLifecyclePolicyRead(ctx, d, meta)...)
+}
+
 func resourceLifecyclePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ECRClient(ctx)
@@ -173,6 +199,55 @@ func findLifecyclePolicyByRepositoryName(ctx context.Context, conn *ecr.Client,
 	return output, nil
 }
 
+// lifecyclePolicyPreviewGuardCustomizeDiff previews a new or changed lifecycle policy and fails the plan
+// if it would expire more images than allowed by preview_guard.
+func lifecyclePolicyPreviewGuardCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.GetOk("preview_guard")
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChanges(names.AttrPolicy, "repository") {
+		return nil
+	}
+
+	if !d.NewValueKnown(names.AttrPolicy) || !d.NewValueKnown("repository") {
+		return nil
+	}
+
+	maxExpiringImages := v.([]interface{})[0].(map[string]interface{})["max_expiring_images"].(int)
+	repositoryName := d.Get("repository").(string)
+
+	policy, err := structure.NormalizeJsonString(d.Get(names.AttrPolicy).(string))
+	if err != nil {
+		return err
+	}
+
+	conn := meta.(*conns.AWSClient).ECRClient(ctx)
+
+	output, err := previewLifecyclePolicy(ctx, conn, "", repositoryName, policy, lifecyclePolicyPreviewTimeout)
+
+	// The repository is created in the same apply, so there are no images to expire.
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("previewing ECR Lifecycle Policy (%s): %w", repositoryName, err)
+	}
+
+	var count int
+	if v := output.Summary; v != nil {
+		count = int(aws.ToInt32(v.ExpiringImageTotalCount))
+	}
+
+	if count > maxExpiringImages {
+		return fmt.Errorf("ECR Lifecycle Policy (%s) would expire %d images, more than preview_guard.max_expiring_images (%d)", repositoryName, count, maxExpiringImages)
+	}
+
+	return nil
+}
+
 type lifecyclePolicyRuleSelection struct {
 	TagStatus      *string   `json:"tagStatus,omitempty"`
 	TagPatternList []*string `json:"tagPatternList,omitempty"`
@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ecr_lifecycle_policy_preview", name="Lifecycle Policy Preview")
+func dataSourceLifecyclePolicyPreview() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceLifecyclePolicyPreviewRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"expiring_image_total_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"images": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"applied_rule_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"applied_rule_priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"image_digest": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"image_pushed_at": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"image_tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			names.AttrPolicy: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"registry_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			names.AttrRepositoryName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceLifecyclePolicyPreviewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRClient(ctx)
+
+	repositoryName := d.Get(names.AttrRepositoryName).(string)
+	var policy, registryID string
+
+	if v, ok := d.GetOk(names.AttrPolicy); ok {
+		v, err := structure.NormalizeJsonString(v.(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		policy = v
+	}
+
+	if v, ok := d.GetOk("registry_id"); ok {
+		registryID = v.(string)
+	}
+
+	output, err := previewLifecyclePolicy(ctx, conn, registryID, repositoryName, policy, d.Timeout(schema.TimeoutRead))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "previewing ECR Lifecycle Policy (%s): %s", repositoryName, err)
+	}
+
+	images, err := flattenLifecyclePolicyPreviewResults(output.PreviewResults, aws.ToString(output.LifecyclePolicyText))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(repositoryName)
+	if v := output.Summary; v != nil {
+		d.Set("expiring_image_total_count", v.ExpiringImageTotalCount)
+	}
+	if err := d.Set("images", images); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting images: %s", err)
+	}
+	d.Set(names.AttrPolicy, output.LifecyclePolicyText)
+	d.Set("registry_id", output.RegistryId)
+	d.Set(names.AttrRepositoryName, output.RepositoryName)
+
+	return diags
+}
+
+// previewLifecyclePolicy starts a lifecycle policy preview for the specified repository and waits for it to complete.
+// If policy is empty the repository's current lifecycle policy is previewed.
+func previewLifecyclePolicy(ctx context.Context, conn *ecr.Client, registryID, repositoryName, policy string, timeout time.Duration) (*ecr.GetLifecyclePolicyPreviewOutput, error) {
+	input := &ecr.StartLifecyclePolicyPreviewInput{
+		RepositoryName: aws.String(repositoryName),
+	}
+
+	if policy != "" {
+		input.LifecyclePolicyText = aws.String(policy)
+	}
+
+	if registryID != "" {
+		input.RegistryId = aws.String(registryID)
+	}
+
+	// Only one preview can be in progress for a repository at a time.
+	_, err := tfresource.RetryWhenIsA[*types.LifecyclePolicyPreviewInProgressException](ctx, timeout, func() (interface{}, error) {
+		return conn.StartLifecyclePolicyPreview(ctx, input)
+	})
+
+	if errs.IsA[*types.LifecyclePolicyNotFoundException](err) || errs.IsA[*types.RepositoryNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := waitLifecyclePolicyPreviewComplete(ctx, conn, registryID, repositoryName, timeout); err != nil {
+		return nil, err
+	}
+
+	return findLifecyclePolicyPreviewByTwoPartKey(ctx, conn, registryID, repositoryName)
+}
+
+// findLifecyclePolicyPreviewByTwoPartKey returns the first page of output with the results from all pages merged into it.
+func findLifecyclePolicyPreviewByTwoPartKey(ctx context.Context, conn *ecr.Client, registryID, repositoryName string) (*ecr.GetLifecyclePolicyPreviewOutput, error) {
+	input := &ecr.GetLifecyclePolicyPreviewInput{
+		RepositoryName: aws.String(repositoryName),
+	}
+
+	if registryID != "" {
+		input.RegistryId = aws.String(registryID)
+	}
+
+	var output *ecr.GetLifecyclePolicyPreviewOutput
+
+	pages := ecr.NewGetLifecyclePolicyPreviewPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.LifecyclePolicyPreviewNotFoundException](err) || errs.IsA[*types.RepositoryNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			output = page
+			continue
+		}
+
+		output.PreviewResults = append(output.PreviewResults, page.PreviewResults...)
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusLifecyclePolicyPreview(ctx context.Context, conn *ecr.Client, registryID, repositoryName string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ecr.GetLifecyclePolicyPreviewInput{
+			MaxResults:     aws.Int32(1),
+			RepositoryName: aws.String(repositoryName),
+		}
+
+		if registryID != "" {
+			input.RegistryId = aws.String(registryID)
+		}
+
+		output, err := conn.GetLifecyclePolicyPreview(ctx, input)
+
+		if errs.IsA[*types.LifecyclePolicyPreviewNotFoundException](err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitLifecyclePolicyPreviewComplete(ctx context.Context, conn *ecr.Client, registryID, repositoryName string, timeout time.Duration) (*ecr.GetLifecyclePolicyPreviewOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.LifecyclePolicyPreviewStatusInProgress),
+		Target:  enum.Slice(types.LifecyclePolicyPreviewStatusComplete),
+		Refresh: statusLifecyclePolicyPreview(ctx, conn, registryID, repositoryName),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ecr.GetLifecyclePolicyPreviewOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func flattenLifecyclePolicyPreviewResults(apiObjects []types.LifecyclePolicyPreviewResult, policy string) ([]interface{}, error) {
+	// Map rule priorities to descriptions so that each image can report the rule that matched it.
+	descriptions := make(map[int64]string)
+	if policy != "" {
+		var lp lifecyclePolicy
+		if err := tfjson.DecodeFromString(policy, &lp); err != nil {
+			return nil, err
+		}
+
+		for _, rule := range lp.Rules {
+			descriptions[aws.ToInt64(rule.RulePriority)] = aws.ToString(rule.Description)
+		}
+	}
+
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		priority := aws.ToInt32(apiObject.AppliedRulePriority)
+		tfMap := map[string]interface{}{
+			"applied_rule_description": descriptions[int64(priority)],
+			"applied_rule_priority":    priority,
+			"image_digest":             aws.ToString(apiObject.ImageDigest),
+			"image_pushed_at":          aws.ToTime(apiObject.ImagePushedAt).Unix(),
+			"image_tags":               apiObject.ImageTags,
+		}
+
+		if v := apiObject.Action; v != nil {
+			tfMap[names.AttrAction] = v.Type
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList, nil
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECRLifecyclePolicyPreviewDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ecr_lifecycle_policy_preview.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePolicyPreviewDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "expiring_image_total_count", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "images.#", acctest.Ct0),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrPolicy),
+					resource.TestCheckResourceAttrPair(dataSourceName, "registry_id", "aws_ecr_repository.test", "registry_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrRepositoryName, "aws_ecr_repository.test", names.AttrName),
+				),
+			},
+		},
+	})
+}
+
+func testAccLifecyclePolicyPreviewDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name = %[1]q
+}
+
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority    = 1
+    description = "Keep the last 10 images"
+
+    selection {
+      tag_status   = "any"
+      count_type   = "imageCountMoreThan"
+      count_number = 10
+    }
+  }
+}
+
+data "aws_ecr_lifecycle_policy_preview" "test" {
+  repository_name = aws_ecr_repository.test.name
+  policy          = data.aws_ecr_lifecycle_policy_document.test.json
+}
+`, rName)
+}
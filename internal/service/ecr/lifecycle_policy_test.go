@@ -144,6 +144,42 @@ func TestAccECRLifecyclePolicy_detectTagPatternListDiff(t *testing.T) {
 	})
 }
 
+func TestAccECRLifecyclePolicy_previewGuard(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecr_lifecycle_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLifecyclePolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePolicyConfig_previewGuard(rName, 14),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "preview_guard.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "preview_guard.0.max_expiring_images", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"preview_guard"},
+			},
+			{
+				// The repository is empty, so the changed policy expires no images.
+				Config: testAccLifecyclePolicyConfig_previewGuard(rName, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(ctx, resourceName),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLifecyclePolicyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRClient(ctx)
@@ -410,3 +446,35 @@ resource "aws_ecr_lifecycle_policy" "test" {
 }
 `, rName)
 }
+
+func testAccLifecyclePolicyConfig_previewGuard(rName string, countNumber int) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecr_lifecycle_policy" "test" {
+  repository = aws_ecr_repository.test.name
+
+  policy = jsonencode({
+    rules = [{
+      rulePriority = 1
+      description  = "Expire untagged images"
+      selection = {
+        tagStatus   = "untagged"
+        countType   = "sinceImagePushed"
+        countUnit   = "days"
+        countNumber = %[2]d
+      }
+      action = {
+        type = "expire"
+      }
+    }]
+  })
+
+  preview_guard {
+    max_expiring_images = 0
+  }
+}
+`, rName, countNumber)
+}
@@ -43,6 +43,21 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_ecr_image",
 			Name:     "Image",
 		},
+		{
+			Factory:  dataSourceImageScanFindings,
+			TypeName: "aws_ecr_image_scan_findings",
+			Name:     "Image Scan Findings",
+		},
+		{
+			Factory:  dataSourceImages,
+			TypeName: "aws_ecr_images",
+			Name:     "Images",
+		},
+		{
+			Factory:  dataSourceLifecyclePolicyPreview,
+			TypeName: "aws_ecr_lifecycle_policy_preview",
+			Name:     "Lifecycle Policy Preview",
+		},
 		{
 			Factory:  dataSourcePullThroughCacheRule,
 			TypeName: "aws_ecr_pull_through_cache_rule",
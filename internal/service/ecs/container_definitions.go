@@ -12,6 +12,7 @@ import (
 	_ "github.com/aws/aws-sdk-go-v2/service/ecs" // Required for go:linkname
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	smithyjson "github.com/aws/smithy-go/encoding/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -211,6 +212,21 @@ func flattenContainerDefinitions(apiObjects []awstypes.ContainerDefinition) (str
 	return jsonEncoder.String(), nil
 }
 
+// normalizeContainerDefinitions returns the container definitions as they are serialized to state.
+func normalizeContainerDefinitions(apiObjects []awstypes.ContainerDefinition) (string, error) {
+	containerDefinitions(apiObjects).orderContainers()
+	containerDefinitions(apiObjects).orderEnvironmentVariables()
+	containerDefinitions(apiObjects).orderSecrets()
+	containerDefinitions(apiObjects).compactArrays()
+
+	v, err := flattenContainerDefinitions(apiObjects)
+	if err != nil {
+		return "", err
+	}
+
+	return structure.NormalizeJsonString(v)
+}
+
 func expandContainerDefinitions(tfString string) ([]awstypes.ContainerDefinition, error) {
 	var apiObjects []awstypes.ContainerDefinition
 
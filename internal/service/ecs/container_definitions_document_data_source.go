@@ -0,0 +1,598 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ecs_container_definitions_document", name="Container Definitions Document")
+func dataSourceContainerDefinitionsDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceContainerDefinitionsDocumentRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			secretSchema := func() *schema.Schema {
+				return &schema.Schema{
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrName: {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"value_from": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				}
+			}
+
+			return map[string]*schema.Schema{
+				"container": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"command": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"cpu": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(0),
+							},
+							"depends_on": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										names.AttrCondition: {
+											Type:             schema.TypeString,
+											Required:         true,
+											ValidateDiagFunc: enum.Validate[awstypes.ContainerCondition](),
+										},
+										"container_name": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+									},
+								},
+							},
+							"docker_labels": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"entry_point": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							names.AttrEnvironment: {
+								Type:     schema.TypeMap,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"environment_file": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										names.AttrType: {
+											Type:             schema.TypeString,
+											Optional:         true,
+											Default:          awstypes.EnvironmentFileTypeS3,
+											ValidateDiagFunc: enum.Validate[awstypes.EnvironmentFileType](),
+										},
+										names.AttrValue: {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+									},
+								},
+							},
+							"essential": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  true,
+							},
+							"firelens_configuration": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"options": {
+											Type:     schema.TypeMap,
+											Optional: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										names.AttrType: {
+											Type:             schema.TypeString,
+											Required:         true,
+											ValidateDiagFunc: enum.Validate[awstypes.FirelensConfigurationType](),
+										},
+									},
+								},
+							},
+							names.AttrHealthCheck: {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"command": {
+											Type:     schema.TypeList,
+											Required: true,
+											MinItems: 1,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										names.AttrInterval: {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											Default:      30,
+											ValidateFunc: validation.IntBetween(5, 300),
+										},
+										"retries": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											Default:      3,
+											ValidateFunc: validation.IntBetween(1, 10),
+										},
+										"start_period": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IntBetween(0, 300),
+										},
+										names.AttrTimeout: {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											Default:      5,
+											ValidateFunc: validation.IntBetween(2, 120),
+										},
+									},
+								},
+							},
+							"hostname": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"image": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"log_configuration": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"log_driver": {
+											Type:             schema.TypeString,
+											Required:         true,
+											ValidateDiagFunc: enum.Validate[awstypes.LogDriver](),
+										},
+										"options": {
+											Type:     schema.TypeMap,
+											Optional: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"secret_option": secretSchema(),
+									},
+								},
+							},
+							"memory": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(6),
+							},
+							"memory_reservation": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(6),
+							},
+							"mount_point": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"container_path": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										"read_only": {
+											Type:     schema.TypeBool,
+											Optional: true,
+										},
+										"source_volume": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+									},
+								},
+							},
+							names.AttrName: {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"port_mapping": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"app_protocol": {
+											Type:             schema.TypeString,
+											Optional:         true,
+											ValidateDiagFunc: enum.Validate[awstypes.ApplicationProtocol](),
+										},
+										"container_port": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IsPortNumber,
+										},
+										"container_port_range": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"host_port": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IsPortNumberOrZero,
+										},
+										names.AttrName: {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										names.AttrProtocol: {
+											Type:             schema.TypeString,
+											Optional:         true,
+											Default:          awstypes.TransportProtocolTcp,
+											ValidateDiagFunc: enum.Validate[awstypes.TransportProtocol](),
+										},
+									},
+								},
+							},
+							"privileged": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"readonly_root_filesystem": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"secret": secretSchema(),
+							"start_timeout": {
+								Type:     schema.TypeInt,
+								Optional: true,
+							},
+							"stop_timeout": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntBetween(0, 120),
+							},
+							"ulimit": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"hard_limit": {
+											Type:     schema.TypeInt,
+											Required: true,
+										},
+										names.AttrName: {
+											Type:             schema.TypeString,
+											Required:         true,
+											ValidateDiagFunc: enum.Validate[awstypes.UlimitName](),
+										},
+										"soft_limit": {
+											Type:     schema.TypeInt,
+											Required: true,
+										},
+									},
+								},
+							},
+							"user": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"volumes_from": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"read_only": {
+											Type:     schema.TypeBool,
+											Optional: true,
+										},
+										"source_container": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+									},
+								},
+							},
+							"working_directory": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+				names.AttrJSON: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"network_mode": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: enum.Validate[awstypes.NetworkMode](),
+				},
+			}
+		},
+	}
+}
+
+func dataSourceContainerDefinitionsDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	networkMode := awstypes.NetworkMode(d.Get("network_mode").(string))
+	apiObjects := expandContainerDefinitionsDocumentContainers(d.Get("container").([]interface{}), networkMode)
+
+	json, err := normalizeContainerDefinitions(apiObjects)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing ECS container definitions JSON: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(json)))
+	d.Set(names.AttrJSON, json)
+
+	return diags
+}
+
+// expandContainerDefinitionsDocumentContainers returns the container definitions with the defaults that
+// ECS fills in on RegisterTaskDefinition, so that the result matches DescribeTaskDefinition output.
+func expandContainerDefinitionsDocumentContainers(tfList []interface{}, networkMode awstypes.NetworkMode) []awstypes.ContainerDefinition {
+	apiObjects := make([]awstypes.ContainerDefinition, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.ContainerDefinition{
+			Essential: aws.Bool(tfMap["essential"].(bool)),
+			Image:     aws.String(tfMap["image"].(string)),
+			Name:      aws.String(tfMap[names.AttrName].(string)),
+			// DescribeTaskDefinition always returns these lists.
+			Environment:    []awstypes.KeyValuePair{},
+			MountPoints:    []awstypes.MountPoint{},
+			PortMappings:   []awstypes.PortMapping{},
+			SystemControls: []awstypes.SystemControl{},
+			VolumesFrom:    []awstypes.VolumeFrom{},
+		}
+
+		if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Command = flex.ExpandStringValueList(v)
+		}
+
+		if v, ok := tfMap["cpu"].(int); ok {
+			apiObject.Cpu = int32(v)
+		}
+
+		if v, ok := tfMap["depends_on"].([]interface{}); ok && len(v) > 0 {
+			for _, tfMapRaw := range v {
+				tfMap := tfMapRaw.(map[string]interface{})
+				apiObject.DependsOn = append(apiObject.DependsOn, awstypes.ContainerDependency{
+					Condition:     awstypes.ContainerCondition(tfMap[names.AttrCondition].(string)),
+					ContainerName: aws.String(tfMap["container_name"].(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["docker_labels"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.DockerLabels = flex.ExpandStringValueMap(v)
+		}
+
+		if v, ok := tfMap["entry_point"].([]interface{}); ok && len(v) > 0 {
+			apiObject.EntryPoint = flex.ExpandStringValueList(v)
+		}
+
+		if v, ok := tfMap[names.AttrEnvironment].(map[string]interface{}); ok {
+			for k, v := range v {
+				apiObject.Environment = append(apiObject.Environment, awstypes.KeyValuePair{
+					Name:  aws.String(k),
+					Value: aws.String(v.(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["environment_file"].([]interface{}); ok && len(v) > 0 {
+			for _, tfMapRaw := range v {
+				tfMap := tfMapRaw.(map[string]interface{})
+				apiObject.EnvironmentFiles = append(apiObject.EnvironmentFiles, awstypes.EnvironmentFile{
+					Type:  awstypes.EnvironmentFileType(tfMap[names.AttrType].(string)),
+					Value: aws.String(tfMap[names.AttrValue].(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["firelens_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.FirelensConfiguration = &awstypes.FirelensConfiguration{
+				Type: awstypes.FirelensConfigurationType(tfMap[names.AttrType].(string)),
+			}
+			if v, ok := tfMap["options"].(map[string]interface{}); ok && len(v) > 0 {
+				apiObject.FirelensConfiguration.Options = flex.ExpandStringValueMap(v)
+			}
+		}
+
+		if v, ok := tfMap[names.AttrHealthCheck].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.HealthCheck = &awstypes.HealthCheck{
+				Command:  flex.ExpandStringValueList(tfMap["command"].([]interface{})),
+				Interval: aws.Int32(int32(tfMap[names.AttrInterval].(int))),
+				Retries:  aws.Int32(int32(tfMap["retries"].(int))),
+				Timeout:  aws.Int32(int32(tfMap[names.AttrTimeout].(int))),
+			}
+			if v, ok := tfMap["start_period"].(int); ok && v != 0 {
+				apiObject.HealthCheck.StartPeriod = aws.Int32(int32(v))
+			}
+		}
+
+		if v, ok := tfMap["hostname"].(string); ok && v != "" {
+			apiObject.Hostname = aws.String(v)
+		}
+
+		if v, ok := tfMap["log_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.LogConfiguration = &awstypes.LogConfiguration{
+				LogDriver: awstypes.LogDriver(tfMap["log_driver"].(string)),
+			}
+			if v, ok := tfMap["options"].(map[string]interface{}); ok && len(v) > 0 {
+				apiObject.LogConfiguration.Options = flex.ExpandStringValueMap(v)
+			}
+			if v, ok := tfMap["secret_option"].([]interface{}); ok && len(v) > 0 {
+				apiObject.LogConfiguration.SecretOptions = expandContainerDefinitionsDocumentSecrets(v)
+			}
+		}
+
+		if v, ok := tfMap["memory"].(int); ok && v != 0 {
+			apiObject.Memory = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["memory_reservation"].(int); ok && v != 0 {
+			apiObject.MemoryReservation = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["mount_point"].([]interface{}); ok {
+			for _, tfMapRaw := range v {
+				tfMap := tfMapRaw.(map[string]interface{})
+				apiObject.MountPoints = append(apiObject.MountPoints, awstypes.MountPoint{
+					ContainerPath: aws.String(tfMap["container_path"].(string)),
+					ReadOnly:      aws.Bool(tfMap["read_only"].(bool)),
+					SourceVolume:  aws.String(tfMap["source_volume"].(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["port_mapping"].([]interface{}); ok {
+			for _, tfMapRaw := range v {
+				tfMap := tfMapRaw.(map[string]interface{})
+				portMapping := awstypes.PortMapping{
+					Protocol: awstypes.TransportProtocol(tfMap[names.AttrProtocol].(string)),
+				}
+				if v, ok := tfMap["app_protocol"].(string); ok && v != "" {
+					portMapping.AppProtocol = awstypes.ApplicationProtocol(v)
+				}
+				if v, ok := tfMap["container_port"].(int); ok && v != 0 {
+					portMapping.ContainerPort = aws.Int32(int32(v))
+				}
+				if v, ok := tfMap["container_port_range"].(string); ok && v != "" {
+					portMapping.ContainerPortRange = aws.String(v)
+				}
+				if v, ok := tfMap["host_port"].(int); ok && v != 0 {
+					portMapping.HostPort = aws.Int32(int32(v))
+				} else if networkMode == awstypes.NetworkModeAwsvpc || networkMode == awstypes.NetworkModeHost {
+					// In awsvpc and host network modes the host port is the container port.
+					portMapping.HostPort = portMapping.ContainerPort
+				}
+				if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
+					portMapping.Name = aws.String(v)
+				}
+				apiObject.PortMappings = append(apiObject.PortMappings, portMapping)
+			}
+		}
+
+		if v, ok := tfMap["privileged"].(bool); ok && v {
+			apiObject.Privileged = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["readonly_root_filesystem"].(bool); ok && v {
+			apiObject.ReadonlyRootFilesystem = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["secret"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Secrets = expandContainerDefinitionsDocumentSecrets(v)
+		}
+
+		if v, ok := tfMap["start_timeout"].(int); ok && v != 0 {
+			apiObject.StartTimeout = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["stop_timeout"].(int); ok && v != 0 {
+			apiObject.StopTimeout = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["ulimit"].([]interface{}); ok && len(v) > 0 {
+			for _, tfMapRaw := range v {
+				tfMap := tfMapRaw.(map[string]interface{})
+				apiObject.Ulimits = append(apiObject.Ulimits, awstypes.Ulimit{
+					HardLimit: int32(tfMap["hard_limit"].(int)),
+					Name:      awstypes.UlimitName(tfMap[names.AttrName].(string)),
+					SoftLimit: int32(tfMap["soft_limit"].(int)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["user"].(string); ok && v != "" {
+			apiObject.User = aws.String(v)
+		}
+
+		if v, ok := tfMap["volumes_from"].([]interface{}); ok {
+			for _, tfMapRaw := range v {
+				tfMap := tfMapRaw.(map[string]interface{})
+				apiObject.VolumesFrom = append(apiObject.VolumesFrom, awstypes.VolumeFrom{
+					ReadOnly:        aws.Bool(tfMap["read_only"].(bool)),
+					SourceContainer: aws.String(tfMap["source_container"].(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["working_directory"].(string); ok && v != "" {
+			apiObject.WorkingDirectory = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandContainerDefinitionsDocumentSecrets(tfList []interface{}) []awstypes.Secret {
+	var apiObjects []awstypes.Secret
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.Secret{
+			Name:      aws.String(tfMap[names.AttrName].(string)),
+			ValueFrom: aws.String(tfMap["value_from"].(string)),
+		})
+	}
+
+	return apiObjects
+}
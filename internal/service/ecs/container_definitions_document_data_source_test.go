@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfecs "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestContainerDefinitionsDocumentDataSource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		raw      map[string]interface{}
+		expected string
+	}{
+		"basic": {
+			raw: map[string]interface{}{
+				"container": []interface{}{
+					map[string]interface{}{
+						names.AttrName: "web",
+						"image":        "nginx",
+						"cpu":          10,
+						"memory":       128,
+						names.AttrEnvironment: map[string]interface{}{
+							"B": "2",
+							"A": "1",
+						},
+						"port_mapping": []interface{}{
+							map[string]interface{}{
+								"container_port": 80,
+							},
+						},
+						"secret": []interface{}{
+							map[string]interface{}{
+								names.AttrName: "Y",
+								"value_from":   "arn:aws:ssm:us-west-2:123456789012:parameter/y",
+							},
+							map[string]interface{}{
+								names.AttrName: "X",
+								"value_from":   "arn:aws:ssm:us-west-2:123456789012:parameter/x",
+							},
+						},
+					},
+				},
+			},
+			expected: `[{"cpu":10,"environment":[{"name":"A","value":"1"},{"name":"B","value":"2"}],"essential":true,"image":"nginx","memory":128,"mountPoints":[],"name":"web","portMappings":[{"containerPort":80,"protocol":"tcp"}],"secrets":[{"name":"X","valueFrom":"arn:aws:ssm:us-west-2:123456789012:parameter/x"},{"name":"Y","valueFrom":"arn:aws:ssm:us-west-2:123456789012:parameter/y"}],"systemControls":[],"volumesFrom":[]}]`,
+		},
+		"full": {
+			raw: map[string]interface{}{
+				"network_mode": "awsvpc",
+				"container": []interface{}{
+					map[string]interface{}{
+						names.AttrName: "log_router",
+						"image":        "public.ecr.aws/aws-observability/aws-for-fluent-bit:stable",
+						"user":         "0",
+						"firelens_configuration": []interface{}{
+							map[string]interface{}{
+								names.AttrType: "fluentbit",
+							},
+						},
+					},
+					map[string]interface{}{
+						names.AttrName: "app",
+						"image":        "nginx",
+						"depends_on": []interface{}{
+							map[string]interface{}{
+								"container_name":    "log_router",
+								names.AttrCondition: "START",
+							},
+						},
+						names.AttrHealthCheck: []interface{}{
+							map[string]interface{}{
+								"command":      []interface{}{"CMD-SHELL", "curl -f http://localhost/ || exit 1"},
+								"start_period": 10,
+							},
+						},
+						"log_configuration": []interface{}{
+							map[string]interface{}{
+								"log_driver": "awsfirelens",
+								"options": map[string]interface{}{
+									"Name": "cloudwatch",
+								},
+							},
+						},
+						"mount_point": []interface{}{
+							map[string]interface{}{
+								"source_volume":  "data",
+								"container_path": "/data",
+								"read_only":      true,
+							},
+						},
+						"port_mapping": []interface{}{
+							map[string]interface{}{
+								names.AttrName:   "http",
+								"container_port": 8080,
+							},
+						},
+					},
+				},
+			},
+			expected: `[{"dependsOn":[{"condition":"START","containerName":"log_router"}],"environment":[],"essential":true,"healthCheck":{"command":["CMD-SHELL","curl -f http://localhost/ || exit 1"],"interval":30,"retries":3,"startPeriod":10,"timeout":5},"image":"nginx","logConfiguration":{"logDriver":"awsfirelens","options":{"Name":"cloudwatch"}},"mountPoints":[{"containerPath":"/data","readOnly":true,"sourceVolume":"data"}],"name":"app","portMappings":[{"containerPort":8080,"hostPort":8080,"name":"http","protocol":"tcp"}],"systemControls":[],"volumesFrom":[]},{"environment":[],"essential":true,"firelensConfiguration":{"type":"fluentbit"},"image":"public.ecr.aws/aws-observability/aws-for-fluent-bit:stable","mountPoints":[],"name":"log_router","portMappings":[],"systemControls":[],"user":"0","volumesFrom":[]}]`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := acctest.Context(t)
+			dataSource := tfecs.DataSourceContainerDefinitionsDocument()
+			d := schema.TestResourceDataRaw(t, dataSource.SchemaMap(), testCase.raw)
+
+			if diags := dataSource.ReadWithoutTimeout(ctx, d, nil); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got, want := d.Get(names.AttrJSON).(string), testCase.expected; got != want {
+				t.Errorf("json = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestAccECSContainerDefinitionsDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ecs_container_definitions_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerDefinitionsDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrJSON, `[{"cpu":10,"environment":[{"name":"A","value":"1"},{"name":"B","value":"2"}],"essential":true,"image":"nginx","memory":128,"mountPoints":[],"name":"web","portMappings":[{"containerPort":80,"protocol":"tcp"}],"secrets":[{"name":"X","valueFrom":"arn:aws:ssm:us-west-2:123456789012:parameter/x"},{"name":"Y","valueFrom":"arn:aws:ssm:us-west-2:123456789012:parameter/y"}],"systemControls":[],"volumesFrom":[]}]`),
+				),
+			},
+		},
+	})
+}
+
+// Registering the document must not produce a diff in the task definition,
+// and the document must match the container definitions returned by DescribeTaskDefinition.
+func TestAccECSContainerDefinitionsDocumentDataSource_taskDefinition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerDefinitionsDocumentDataSourceConfig_taskDefinition(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("aws_ecs_task_definition.test", "container_definitions", "data.aws_ecs_container_definitions_document.test", names.AttrJSON),
+					testAccCheckContainerDefinitionsDocumentMatchesTaskDefinition(ctx, "data.aws_ecs_container_definitions_document.test", "aws_ecs_task_definition.test"),
+				),
+			},
+			{
+				Config:   testAccContainerDefinitionsDocumentDataSourceConfig_taskDefinition(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckContainerDefinitionsDocumentMatchesTaskDefinition(ctx context.Context, dataSourceName, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ds, ok := s.RootModule().Resources[dataSourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", dataSourceName)
+		}
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSClient(ctx)
+
+		output, _, err := tfecs.FindTaskDefinitionByFamilyOrARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+		if err != nil {
+			return err
+		}
+
+		described, err := tfecs.NormalizeContainerDefinitions(output.ContainerDefinitions)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := ds.Primary.Attributes[names.AttrJSON], described; got != want {
+			return fmt.Errorf("%s json = %s, want (DescribeTaskDefinition) %s", dataSourceName, got, want)
+		}
+
+		return nil
+	}
+}
+
+const testAccContainerDefinitionsDocumentDataSourceConfig_basic = `
+data "aws_ecs_container_definitions_document" "test" {
+  container {
+    name   = "web"
+    image  = "nginx"
+    cpu    = 10
+    memory = 128
+
+    environment = {
+      B = "2"
+      A = "1"
+    }
+
+    port_mapping {
+      container_port = 80
+    }
+
+    secret {
+      name       = "Y"
+      value_from = "arn:aws:ssm:us-west-2:123456789012:parameter/y"
+    }
+
+    secret {
+      name       = "X"
+      value_from = "arn:aws:ssm:us-west-2:123456789012:parameter/x"
+    }
+  }
+}
+`
+
+func testAccContainerDefinitionsDocumentDataSourceConfig_taskDefinition(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ecs_container_definitions_document" "test" {
+  network_mode = "awsvpc"
+
+  container {
+    name   = "web"
+    image  = "nginx"
+    memory = 128
+
+    environment = {
+      B = "2"
+      A = "1"
+    }
+
+    health_check {
+      command = ["CMD-SHELL", "exit 0"]
+    }
+
+    port_mapping {
+      container_port = 80
+    }
+  }
+
+  container {
+    name   = "sidecar"
+    image  = "busybox"
+    memory = 64
+
+    command = ["sleep", "3600"]
+  }
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family                = %[1]q
+  network_mode          = "awsvpc"
+  container_definitions = data.aws_ecs_container_definitions_document.test.json
+}
+`, rName)
+}
@@ -14,6 +14,8 @@ var (
 	ResourceTaskDefinition           = resourceTaskDefinition
 	ResourceTaskSet                  = resourceTaskSet
 
+	DataSourceContainerDefinitionsDocument = dataSourceContainerDefinitionsDocument
+
 	ClusterNameFromARN                      = clusterNameFromARN
 	FindCapacityProviderByARN               = findCapacityProviderByARN
 	FindClusterByNameOrARN                  = findClusterByNameOrARN
@@ -22,6 +24,7 @@ var (
 	FindTag                                 = findTag
 	FindTaskDefinitionByFamilyOrARN         = findTaskDefinitionByFamilyOrARN
 	FindTaskSetNoTagsByThreePartKey         = findTaskSetNoTagsByThreePartKey
+	NormalizeContainerDefinitions           = normalizeContainerDefinitions
 	RoleNameFromARN                         = roleNameFromARN
 	TaskDefinitionARNStripRevision          = taskDefinitionARNStripRevision
 	ValidTaskDefinitionContainerDefinitions = validTaskDefinitionContainerDefinitions
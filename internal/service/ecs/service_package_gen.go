@@ -35,6 +35,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_ecs_container_definition",
 			Name:     "Container Definition",
 		},
+		{
+			Factory:  dataSourceContainerDefinitionsDocument,
+			TypeName: "aws_ecs_container_definitions_document",
+			Name:     "Container Definitions Document",
+		},
 		{
 			Factory:  dataSourceService,
 			TypeName: "aws_ecs_service",
@@ -18,7 +18,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
@@ -90,12 +89,7 @@ func resourceTaskDefinition() *schema.Resource {
 						// Mimic the pre-v5.59.0 behavior.
 						return "[]"
 					}
-					containerDefinitions(orderedCDs).orderContainers()
-					containerDefinitions(orderedCDs).orderEnvironmentVariables()
-					containerDefinitions(orderedCDs).orderSecrets()
-					containerDefinitions(orderedCDs).compactArrays()
-					unnormalizedJson, _ := flattenContainerDefinitions(orderedCDs)
-					json, _ := structure.NormalizeJsonString(unnormalizedJson)
+					json, _ := normalizeContainerDefinitions(orderedCDs)
 					return json
 				},
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
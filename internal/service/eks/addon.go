@@ -16,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,6 +29,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/xeipuuv/gojsonschema"
+	"gopkg.in/yaml.v2"
 )
 
 // @SDKResource("aws_eks_addon", name="Add-On")
@@ -43,7 +46,10 @@ func resourceAddon() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			addonConfigurationValuesCustomizeDiff,
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
@@ -356,6 +362,32 @@ func findAddonByTwoPartKey(ctx context.Context, conn *eks.Client, clusterName, a
 	return output.Addon, nil
 }
 
+func findAddonConfigurationByTwoPartKey(ctx context.Context, conn *eks.Client, addonName, addonVersion string) (*eks.DescribeAddonConfigurationOutput, error) {
+	input := &eks.DescribeAddonConfigurationInput{
+		AddonName:    aws.String(addonName),
+		AddonVersion: aws.String(addonVersion),
+	}
+
+	output, err := conn.DescribeAddonConfiguration(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConfigurationSchema == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func findAddonUpdateByThreePartKey(ctx context.Context, conn *eks.Client, clusterName, addonName, id string) (*types.Update, error) {
 	input := &eks.DescribeUpdateInput{
 		AddonName: aws.String(addonName),
@@ -383,6 +415,129 @@ func findAddonUpdateByThreePartKey(ctx context.Context, conn *eks.Client, cluste
 	return output.Update, nil
 }
 
+// addonConfigurationValuesCustomizeDiff validates configuration_values against the JSON schema published for the add-on version,
+// so that invalid values are reported during plan rather than when the add-on becomes DEGRADED.
+func addonConfigurationValuesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("addon_version", "configuration_values") {
+		return nil
+	}
+
+	if !d.NewValueKnown("addon_name") || !d.NewValueKnown(names.AttrClusterName) || !d.NewValueKnown("configuration_values") {
+		return nil
+	}
+
+	configurationValues := d.Get("configuration_values").(string)
+	if configurationValues == "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+	addonName := d.Get("addon_name").(string)
+
+	// addon_version is Optional+Computed, so it is unknown when an add-on is created without a pinned version.
+	// Validate against the version that EKS installs by default in that case.
+	var addonVersion string
+	if d.NewValueKnown("addon_version") {
+		addonVersion = d.Get("addon_version").(string)
+	}
+
+	if addonVersion == "" {
+		clusterName := d.Get(names.AttrClusterName).(string)
+		cluster, err := findClusterByName(ctx, conn, clusterName)
+
+		// The cluster is created in the same apply.
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading EKS Cluster (%s): %w", clusterName, err)
+		}
+
+		versionInfo, err := findAddonVersionByTwoPartKey(ctx, conn, addonName, aws.ToString(cluster.Version), false)
+
+		if err != nil {
+			return fmt.Errorf("reading EKS Add-On (%s) default version: %w", addonName, err)
+		}
+
+		addonVersion = aws.ToString(versionInfo.AddonVersion)
+	}
+
+	output, err := findAddonConfigurationByTwoPartKey(ctx, conn, addonName, addonVersion)
+
+	if errs.IsA[*types.AccessDeniedException](err) {
+		log.Printf("[WARN] Unable to validate EKS Add-On (%s) configuration_values: %s", addonName, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading EKS Add-On (%s) version (%s) configuration schema: %w", addonName, addonVersion, err)
+	}
+
+	return validateAddonConfigurationValues(aws.ToString(output.ConfigurationSchema), configurationValues)
+}
+
+// validateAddonConfigurationValues validates add-on configuration values, in JSON or YAML format, against a JSON schema.
+func validateAddonConfigurationValues(configurationSchema, configurationValues string) error {
+	// JSON is a subset of YAML.
+	var v interface{}
+	if err := yaml.Unmarshal([]byte(configurationValues), &v); err != nil {
+		return fmt.Errorf("configuration_values is not valid JSON or YAML: %w", err)
+	}
+
+	v, err := yamlToJSONCompatible(v)
+	if err != nil {
+		return fmt.Errorf("configuration_values: %w", err)
+	}
+
+	result, err := gojsonschema.Validate(gojsonschema.NewStringLoader(configurationSchema), gojsonschema.NewGoLoader(v))
+	if err != nil {
+		return fmt.Errorf("validating configuration_values: %w", err)
+	}
+
+	if result.Valid() {
+		return nil
+	}
+
+	for _, v := range result.Errors() {
+		err = errors.Join(err, fmt.Errorf("configuration_values: %s: %s", v.Field(), v.Description()))
+	}
+
+	return err
+}
+
+// yamlToJSONCompatible converts the map[interface{}]interface{} values produced by the YAML decoder to map[string]interface{}.
+func yamlToJSONCompatible(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, v := range v {
+			key, ok := k.(string)
+			if !ok {
+				return nil, fmt.Errorf("unsupported map key type: %T", k)
+			}
+			v, err := yamlToJSONCompatible(v)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = v
+		}
+		return m, nil
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for i, v := range v {
+			v, err := yamlToJSONCompatible(v)
+			if err != nil {
+				return nil, err
+			}
+			s[i] = v
+		}
+		return s, nil
+	default:
+		return v, nil
+	}
+}
+
 func statusAddon(ctx context.Context, conn *eks.Client, clusterName, addonName string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findAddonByTwoPartKey(ctx, conn, clusterName, addonName)
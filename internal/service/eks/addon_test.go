@@ -6,6 +6,7 @@ package eks_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/YakDriver/regexache"
@@ -345,12 +346,113 @@ func TestAccEKSAddon_configurationValues(t *testing.T) {
 			},
 			{
 				Config:      testAccAddonConfig_configurationValues(rName, addonName, addonVersion, invalidConfigurationValues, string(types.ResolveConflictsOverwrite)),
-				ExpectError: regexache.MustCompile(`InvalidParameterException: ConfigurationValue provided in request is not supported`),
+				ExpectError: regexache.MustCompile(`configuration_values: env: Additional property INVALID_FIELD is not allowed`),
 			},
 		},
 	})
 }
 
+// Without a pinned addon_version, configuration_values are validated against the default version.
+func TestAccEKSAddon_configurationValuesDefaultVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	invalidConfigurationValues := "{\"env\": {\"INVALID_FIELD\":\"2\"}}"
+	addonName := "vpc-cni"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t); testAccPreCheckAddon(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAddonDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// The default version can only be resolved once the cluster exists.
+				Config: testAccAddonConfig_base(rName),
+			},
+			{
+				Config:      testAccAddonConfig_configurationValuesDefaultVersion(rName, addonName, invalidConfigurationValues),
+				ExpectError: regexache.MustCompile(`configuration_values: env: Additional property INVALID_FIELD is not allowed`),
+			},
+		},
+	})
+}
+
+func TestValidateAddonConfigurationValues(t *testing.T) {
+	t.Parallel()
+
+	configurationSchema := `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "additionalProperties": false,
+  "properties": {
+    "replicaCount": {"type": "integer"},
+    "resources": {
+      "type": "object",
+      "properties": {
+        "limits": {
+          "type": "object",
+          "properties": {
+            "cpu": {"type": "string"}
+          }
+        }
+      }
+    }
+  }
+}`
+
+	testCases := map[string]struct {
+		configurationValues string
+		expectedErrors      []string
+	}{
+		"valid JSON": {
+			configurationValues: `{"replicaCount": 2, "resources": {"limits": {"cpu": "100m"}}}`,
+		},
+		"valid YAML": {
+			configurationValues: "replicaCount: 2\nresources:\n  limits:\n    cpu: 100m\n",
+		},
+		"invalid type": {
+			configurationValues: `{"replicaCount": "two"}`,
+			expectedErrors:      []string{"configuration_values: replicaCount: Invalid type. Expected: integer, given: string"},
+		},
+		"nested invalid type and additional property": {
+			configurationValues: `{"resources": {"limits": {"cpu": 1}}, "foo": "bar"}`,
+			expectedErrors: []string{
+				"configuration_values: (root): Additional property foo is not allowed",
+				"configuration_values: resources.limits.cpu: Invalid type. Expected: string, given: integer",
+			},
+		},
+		"not JSON or YAML": {
+			configurationValues: "replicaCount: [",
+			expectedErrors:      []string{"configuration_values is not valid JSON or YAML"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfeks.ValidateAddonConfigurationValues(configurationSchema, testCase.configurationValues)
+
+			if len(testCase.expectedErrors) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			for _, expected := range testCase.expectedErrors {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected error to contain %q, got: %s", expected, err)
+				}
+			}
+		})
+	}
+}
+
 func TestAccEKSAddon_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var addon1, addon2, addon3 types.Addon
@@ -653,3 +755,13 @@ resource "aws_eks_addon" "test" {
 }
 `, rName, addonName, addonVersion, configurationValues, resolveConflicts))
 }
+
+func testAccAddonConfig_configurationValuesDefaultVersion(rName, addonName, configurationValues string) string {
+	return acctest.ConfigCompose(testAccAddonConfig_base(rName), fmt.Sprintf(`
+resource "aws_eks_addon" "test" {
+  cluster_name         = aws_eks_cluster.test.name
+  addon_name           = %[2]q
+  configuration_values = %[3]q
+}
+`, rName, addonName, configurationValues))
+}
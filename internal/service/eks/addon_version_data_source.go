@@ -5,6 +5,7 @@ package eks
 
 import (
 	"context"
+	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
@@ -31,6 +32,10 @@ func dataSourceAddonVersion() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			"configuration_schema": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"kubernetes_version": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -61,11 +66,25 @@ func dataSourceAddonVersionRead(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "reading EKS Add-On version info (%s, %s): %s", addonName, kubernetesVersion, err)
 	}
 
+	addonVersion := aws.ToString(versionInfo.AddonVersion)
+	var configurationSchema *string
+	output, err := findAddonConfigurationByTwoPartKey(ctx, conn, addonName, addonVersion)
+
+	switch {
+	case errs.IsA[*types.AccessDeniedException](err):
+		log.Printf("[WARN] Unable to read EKS Add-On (%s) version (%s) configuration schema: %s", addonName, addonVersion, err)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading EKS Add-On (%s) version (%s) configuration schema: %s", addonName, addonVersion, err)
+	default:
+		configurationSchema = output.ConfigurationSchema
+	}
+
 	d.SetId(addonName)
 	d.Set("addon_name", addonName)
+	d.Set("configuration_schema", configurationSchema)
 	d.Set("kubernetes_version", kubernetesVersion)
 	d.Set(names.AttrMostRecent, mostRecent)
-	d.Set(names.AttrVersion, versionInfo.AddonVersion)
+	d.Set(names.AttrVersion, addonVersion)
 
 	return diags
 }
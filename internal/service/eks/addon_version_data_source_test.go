@@ -31,6 +31,7 @@ func TestAccEKSAddonVersionDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(versionDataSourceName, names.AttrVersion, addonDataSourceName, "addon_version"),
 					resource.TestCheckResourceAttrPair(versionDataSourceName, "addon_name", addonDataSourceName, "addon_name"),
 					resource.TestCheckResourceAttr(versionDataSourceName, names.AttrMostRecent, acctest.CtTrue),
+					acctest.CheckResourceAttrIsJSONString(versionDataSourceName, "configuration_schema"),
 				),
 			},
 			{
@@ -272,6 +272,21 @@ func resourceCluster() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"upgrade_gate": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"severity": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      types.InsightStatusValueError,
+							ValidateFunc: validation.StringInSlice(enum.Slice(types.InsightStatusValueWarning, types.InsightStatusValueError), false),
+						},
+					},
+				},
+			},
 			"upgrade_policy": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -504,9 +519,22 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 
 	// Do any version update first.
 	if d.HasChange(names.AttrVersion) {
+		version := d.Get(names.AttrVersion).(string)
+
+		if v, ok := d.GetOk("upgrade_gate"); ok && len(v.([]interface{})) > 0 {
+			severity := types.InsightStatusValueError
+			if tfMap, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+				severity = types.InsightStatusValue(tfMap["severity"].(string))
+			}
+
+			if diags := checkClusterUpgradeInsights(ctx, conn, d.Id(), version, severity); diags.HasError() {
+				return diags
+			}
+		}
+
 		input := &eks.UpdateClusterVersionInput{
 			Name:    aws.String(d.Id()),
-			Version: aws.String(d.Get(names.AttrVersion).(string)),
+			Version: aws.String(version),
 		}
 
 		output, err := conn.UpdateClusterVersion(ctx, input)
@@ -728,6 +756,49 @@ func findClusterByName(ctx context.Context, conn *eks.Client, name string) (*typ
 	return output.Cluster, nil
 }
 
+// checkClusterUpgradeInsights returns an error diagnostic for each upgrade readiness insight
+// for the target Kubernetes version whose status is at or above the specified severity.
+func checkClusterUpgradeInsights(ctx context.Context, conn *eks.Client, name, version string, severity types.InsightStatusValue) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	statuses := []types.InsightStatusValue{types.InsightStatusValueError}
+	if severity == types.InsightStatusValueWarning {
+		statuses = append(statuses, types.InsightStatusValueWarning)
+	}
+
+	input := &eks.ListInsightsInput{
+		ClusterName: aws.String(name),
+		Filter: &types.InsightsFilter{
+			Categories:         []types.Category{types.CategoryUpgradeReadiness},
+			KubernetesVersions: []string{version},
+			Statuses:           statuses,
+		},
+	}
+
+	insights, err := findInsights(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Cluster (%s) upgrade insights: %s", name, err)
+	}
+
+	for _, insight := range insights {
+		var status types.InsightStatusValue
+		var reason string
+		if v := insight.InsightStatus; v != nil {
+			status = v.Status
+			reason = aws.ToString(v.Reason)
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("EKS Cluster (%s) upgrade to version %s blocked by insight %q (%s)", name, version, aws.ToString(insight.Name), status),
+			Detail:   fmt.Sprintf("%s\n\nInsight ID: %s\nReason: %s", aws.ToString(insight.Description), aws.ToString(insight.Id), reason),
+		})
+	}
+
+	return diags
+}
+
 func updateVPCConfig(ctx context.Context, conn *eks.Client, name string, vpcConfig *types.VpcConfigRequest, timeout time.Duration) error {
 	input := &eks.UpdateClusterConfigInput{
 		Name:               aws.String(name),
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSClusterInsightsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceResourceName := "data.aws_eks_cluster_insights.test"
+	resourceName := "aws_eks_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterInsightsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceResourceName, names.AttrClusterName, resourceName, names.AttrName),
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceResourceName, "insights.#", 0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSClusterInsightsDataSource_filter(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceResourceName := "data.aws_eks_cluster_insights.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// A cluster without workloads has no insights in ERROR status.
+				Config: testAccClusterInsightsDataSourceConfig_filter(rName, "ERROR"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceResourceName, "categories.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(dataSourceResourceName, "categories.*", "UPGRADE_READINESS"),
+					resource.TestCheckResourceAttr(dataSourceResourceName, "insights.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceResourceName, "statuses.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(dataSourceResourceName, "statuses.*", "ERROR"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterInsightsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_basic(rName), `
+data "aws_eks_cluster_insights" "test" {
+  cluster_name = aws_eks_cluster.test.name
+}
+`)
+}
+
+func testAccClusterInsightsDataSourceConfig_filter(rName, status string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_basic(rName), fmt.Sprintf(`
+data "aws_eks_cluster_insights" "test" {
+  cluster_name = aws_eks_cluster.test.name
+  categories   = ["UPGRADE_READINESS"]
+  statuses     = [%[1]q]
+}
+`, status))
+}
@@ -440,6 +440,47 @@ func TestAccEKSCluster_version(t *testing.T) {
 	})
 }
 
+func TestAccEKSCluster_upgradeGate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster1, cluster2 types.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_upgradeGate(rName, clusterVersionUpgradeInitial, "ERROR"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster1),
+					resource.TestCheckResourceAttr(resourceName, "upgrade_gate.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "upgrade_gate.0.severity", "ERROR"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, clusterVersionUpgradeInitial),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bootstrap_self_managed_addons", "upgrade_gate"},
+			},
+			{
+				// A cluster without workloads has no failing upgrade readiness insights.
+				Config: testAccClusterConfig_upgradeGate(rName, clusterVersionUpgradeUpdated, "WARNING"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster2),
+					testAccCheckClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "upgrade_gate.0.severity", "WARNING"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, clusterVersionUpgradeUpdated),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEKSCluster_logging(t *testing.T) {
 	ctx := acctest.Context(t)
 	var cluster1, cluster2 types.Cluster
@@ -1539,3 +1580,23 @@ resource "aws_eks_cluster" "test" {
 }
 `, rName, supportType))
 }
+
+func testAccClusterConfig_upgradeGate(rName, version, severity string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_base(rName), fmt.Sprintf(`
+resource "aws_eks_cluster" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+  version  = %[2]q
+
+  vpc_config {
+    subnet_ids = aws_subnet.test[*].id
+  }
+
+  upgrade_gate {
+    severity = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test-AmazonEKSClusterPolicy]
+}
+`, rName, version, severity))
+}
@@ -53,6 +53,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceClusterAuth,
 			TypeName: "aws_eks_cluster_auth",
 		},
+		{
+			Factory:  dataSourceClusterInsights,
+			TypeName: "aws_eks_cluster_insights",
+			Name:     "Cluster Insights",
+		},
 		{
 			Factory:  dataSourceClusters,
 			TypeName: "aws_eks_clusters",
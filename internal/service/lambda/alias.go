@@ -8,14 +8,19 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -35,6 +40,10 @@ func resourceAlias() *schema.Resource {
 			StateContext: resourceAliasImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -64,9 +73,10 @@ func resourceAlias() *schema.Resource {
 				ForceNew: true,
 			},
 			"routing_config": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"traffic_shift"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"additional_version_weights": {
@@ -77,6 +87,37 @@ func resourceAlias() *schema.Resource {
 					},
 				},
 			},
+			"traffic_shift": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"routing_config"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarms": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 100,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"interval_in_seconds": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 3600),
+						},
+						"step_percentage": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatBetween(1, 99),
+						},
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[aliasTrafficShiftType](),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -139,6 +180,21 @@ func resourceAliasUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
 
+	if v, ok := d.GetOk("traffic_shift"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil && d.HasChange("function_version") {
+		ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+		defer cancel()
+
+		o, n := d.GetChange("function_version")
+		oldVersion, newVersion := o.(string), n.(string)
+
+		if err := shiftAliasTraffic(ctx, conn, meta.(*conns.AWSClient).CloudWatchClient(ctx), d, oldVersion, newVersion, v.([]interface{})[0].(map[string]interface{})); err != nil {
+			// Keep the previous version in state so that the next apply retries the shift.
+			d.Partial(true)
+
+			return sdkdiag.AppendErrorf(diags, "updating Lambda Alias (%s): %s", d.Id(), err)
+		}
+	}
+
 	input := &lambda.UpdateAliasInput{
 		Description:     aws.String(d.Get(names.AttrDescription).(string)),
 		FunctionName:    aws.String(d.Get("function_name").(string)),
@@ -156,6 +212,119 @@ func resourceAliasUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	return append(diags, resourceAliasRead(ctx, d, meta)...)
 }
 
+// shiftAliasTraffic gradually routes traffic from oldVersion to newVersion, waiting between steps.
+// If any of the configured CloudWatch alarms fires the alias is rolled back to oldVersion.
+// On success the alias still points at oldVersion with the last step's share of traffic routed to newVersion, which is less than 100%.
+// The caller's final UpdateAlias moves the remaining traffic by pointing the alias at newVersion.
+func shiftAliasTraffic(ctx context.Context, conn *lambda.Client, cloudWatchConn *cloudwatch.Client, d *schema.ResourceData, oldVersion, newVersion string, tfMap map[string]interface{}) error {
+	functionName := d.Get("function_name").(string)
+	name := d.Get(names.AttrName).(string)
+	alarmNames := flex.ExpandStringValueSet(tfMap["alarms"].(*schema.Set))
+	interval := time.Duration(tfMap["interval_in_seconds"].(int)) * time.Second
+
+	for _, weight := range aliasTrafficShiftWeights(tfMap[names.AttrType].(string), tfMap["step_percentage"].(float64)) {
+		input := &lambda.UpdateAliasInput{
+			FunctionName:    aws.String(functionName),
+			FunctionVersion: aws.String(oldVersion),
+			Name:            aws.String(name),
+			RoutingConfig: &awstypes.AliasRoutingConfiguration{
+				AdditionalVersionWeights: map[string]float64{
+					newVersion: weight,
+				},
+			},
+		}
+
+		log.Printf("[DEBUG] Shifting %.2f%% of Lambda Alias (%s) traffic to version %s", weight*100, d.Id(), newVersion)
+		_, err := conn.UpdateAlias(ctx, input)
+
+		if err == nil {
+			err = waitAliasTrafficShiftStep(ctx, cloudWatchConn, alarmNames, interval)
+		}
+
+		if err != nil {
+			input := &lambda.UpdateAliasInput{
+				FunctionName:    aws.String(functionName),
+				FunctionVersion: aws.String(oldVersion),
+				Name:            aws.String(name),
+				RoutingConfig:   &awstypes.AliasRoutingConfiguration{},
+			}
+
+			// Use a fresh context so that the rollback happens even if the timeout has been reached.
+			if _, rollbackErr := conn.UpdateAlias(context.WithoutCancel(ctx), input); rollbackErr != nil {
+				return fmt.Errorf("shifting traffic to version %s: %w; rolling back to version %s: %w", newVersion, err, oldVersion, rollbackErr)
+			}
+
+			return fmt.Errorf("shifting traffic to version %s (rolled back to version %s): %w", newVersion, oldVersion, err)
+		}
+	}
+
+	return nil
+}
+
+// aliasTrafficShiftWeights returns the weights of the new version for each step of a traffic shift.
+func aliasTrafficShiftWeights(shiftType string, percentage float64) []float64 {
+	var weights []float64
+
+	switch aliasTrafficShiftType(shiftType) {
+	case aliasTrafficShiftTypeCanary:
+		weights = append(weights, percentage/100)
+	case aliasTrafficShiftTypeLinear:
+		for i := 1; float64(i)*percentage < 100; i++ {
+			weights = append(weights, float64(i)*percentage/100)
+		}
+	}
+
+	return weights
+}
+
+// waitAliasTrafficShiftStep waits for the specified interval, returning an error as soon as any of the specified alarms is in ALARM state.
+func waitAliasTrafficShiftStep(ctx context.Context, conn *cloudwatch.Client, alarmNames []string, interval time.Duration) error {
+	end := time.Now().Add(interval)
+
+	return tfresource.WaitUntil(ctx, interval+aliasTrafficShiftPollInterval, func() (bool, error) {
+		if len(alarmNames) > 0 {
+			alarming, err := findAlarmNamesInAlarmState(ctx, conn, alarmNames)
+
+			if err != nil {
+				return false, fmt.Errorf("reading CloudWatch Alarms: %w", err)
+			}
+
+			if len(alarming) > 0 {
+				return false, fmt.Errorf("CloudWatch Alarms in ALARM state: %s", strings.Join(alarming, ", "))
+			}
+		}
+
+		return !time.Now().Before(end), nil
+	}, tfresource.WaitOpts{PollInterval: aliasTrafficShiftPollInterval})
+}
+
+func findAlarmNamesInAlarmState(ctx context.Context, conn *cloudwatch.Client, alarmNames []string) ([]string, error) {
+	input := &cloudwatch.DescribeAlarmsInput{
+		AlarmNames: alarmNames,
+		AlarmTypes: []cloudwatchtypes.AlarmType{cloudwatchtypes.AlarmTypeCompositeAlarm, cloudwatchtypes.AlarmTypeMetricAlarm},
+		StateValue: cloudwatchtypes.StateValueAlarm,
+	}
+	var output []string
+
+	pages := cloudwatch.NewDescribeAlarmsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.CompositeAlarms {
+			output = append(output, aws.ToString(v.AlarmName))
+		}
+		for _, v := range page.MetricAlarms {
+			output = append(output, aws.ToString(v.AlarmName))
+		}
+	}
+
+	return output, nil
+}
+
 func resourceAliasDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
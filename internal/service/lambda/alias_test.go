@@ -6,6 +6,7 @@ package lambda_test
 import (
 	"context"
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -223,6 +224,98 @@ func TestAccLambdaAlias_routing(t *testing.T) {
 	})
 }
 
+func TestAccLambdaAlias_trafficShift(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetAliasOutput
+	resourceName := "aws_lambda_alias.test"
+	rString := sdkacctest.RandString(8)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_alias_basic_%s", rString)
+	policyName := fmt.Sprintf("tf_acc_policy_lambda_alias_basic_%s", rString)
+	attachmentName := fmt.Sprintf("tf_acc_attachment_%s", rString)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_alias_basic_%s", rString)
+	aliasName := fmt.Sprintf("tf_acc_lambda_alias_basic_%s", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAliasConfig_trafficShift(roleName, policyName, attachmentName, funcName, aliasName, "lambdatest.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "function_version", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "traffic_shift.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "traffic_shift.0.alarms.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "traffic_shift.0.interval_in_seconds", "5"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_shift.0.step_percentage", "50"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_shift.0.type", "LINEAR"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAliasImportStateIDFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"traffic_shift"},
+			},
+			{
+				Config: testAccAliasConfig_trafficShift(roleName, policyName, attachmentName, funcName, aliasName, "lambdatest_modified.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &conf),
+					testAccCheckAliasRoutingDoesNotExistConfig(&conf),
+					resource.TestCheckResourceAttr(resourceName, "function_version", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAliasTrafficShiftWeights(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		shiftType  string
+		percentage float64
+		expected   []float64
+	}{
+		"canary": {
+			shiftType:  "CANARY",
+			percentage: 10,
+			expected:   []float64{0.1},
+		},
+		"linear": {
+			shiftType:  "LINEAR",
+			percentage: 25,
+			expected:   []float64{0.25, 0.5, 0.75},
+		},
+		"linear uneven": {
+			shiftType:  "LINEAR",
+			percentage: 40,
+			expected:   []float64{0.4, 0.8},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tflambda.AliasTrafficShiftWeights(testCase.shiftType, testCase.percentage)
+
+			if len(got) != len(testCase.expected) {
+				t.Fatalf("expected %v, got %v", testCase.expected, got)
+			}
+
+			for i := range got {
+				if math.Abs(got[i]-testCase.expected[i]) > 1e-9 {
+					t.Errorf("expected %v, got %v", testCase.expected, got)
+				}
+			}
+		})
+	}
+}
+
 func testAccCheckAliasDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
@@ -452,3 +545,49 @@ resource "aws_lambda_alias" "test" {
 }
 `, funcName, aliasName))
 }
+
+func testAccAliasConfig_trafficShift(roleName, policyName, attachmentName, funcName, aliasName, filename string) string {
+	return acctest.ConfigCompose(
+		testAccAliasConfig_base(roleName, policyName, attachmentName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename         = "test-fixtures/%[3]s"
+  function_name    = %[1]q
+  role             = aws_iam_role.iam_for_lambda.arn
+  handler          = "exports.example"
+  runtime          = "nodejs16.x"
+  source_code_hash = filebase64sha256("test-fixtures/%[3]s")
+  publish          = "true"
+}
+
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[2]q
+  comparison_operator = "GreaterThanThreshold"
+  evaluation_periods  = 1
+  metric_name         = "Errors"
+  namespace           = "AWS/Lambda"
+  period              = 60
+  statistic           = "Sum"
+  threshold           = 0
+  treat_missing_data  = "notBreaching"
+
+  dimensions = {
+    FunctionName = aws_lambda_function.test.function_name
+    Resource     = "${aws_lambda_function.test.function_name}:%[2]s"
+  }
+}
+
+resource "aws_lambda_alias" "test" {
+  name             = %[2]q
+  function_name    = aws_lambda_function.test.arn
+  function_version = aws_lambda_function.test.version
+
+  traffic_shift {
+    type                = "LINEAR"
+    step_percentage     = 50
+    interval_in_seconds = 5
+    alarms              = [aws_cloudwatch_metric_alarm.test.alarm_name]
+  }
+}
+`, funcName, aliasName, filename))
+}
@@ -18,10 +18,25 @@ const (
 )
 
 const (
-	iamPropagationTimeout    = 2 * time.Minute
-	lambdaPropagationTimeout = 5 * time.Minute // nosemgrep:ci.lambda-in-const-name, ci.lambda-in-var-name
+	aliasTrafficShiftPollInterval = 10 * time.Second
+	iamPropagationTimeout         = 2 * time.Minute
+	lambdaPropagationTimeout      = 5 * time.Minute // nosemgrep:ci.lambda-in-const-name, ci.lambda-in-var-name
 )
 
+type aliasTrafficShiftType string
+
+const (
+	aliasTrafficShiftTypeCanary aliasTrafficShiftType = "CANARY"
+	aliasTrafficShiftTypeLinear aliasTrafficShiftType = "LINEAR"
+)
+
+func (aliasTrafficShiftType) Values() []aliasTrafficShiftType {
+	return []aliasTrafficShiftType{
+		aliasTrafficShiftTypeCanary,
+		aliasTrafficShiftTypeLinear,
+	}
+}
+
 type invocationAction string
 
 const (
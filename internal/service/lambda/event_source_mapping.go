@@ -182,6 +182,29 @@ func resourceEventSourceMapping() *schema.Resource {
 					},
 				},
 			},
+			"filter_test": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"records": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsJSON,
+							},
+						},
+					},
+				},
+			},
+			"filter_test_results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeBool},
+			},
 			names.AttrFunctionARN: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -367,6 +390,8 @@ func resourceEventSourceMapping() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: customizeDiffEventSourceMappingFilter,
 	}
 }
 
@@ -477,6 +502,8 @@ func resourceEventSourceMappingCreate(ctx context.Context, d *schema.ResourceDat
 		input.TumblingWindowInSeconds = aws.Int32(int32(v.(int)))
 	}
 
+	diags = append(diags, filterCriteriaWarnings(d)...)
+
 	// IAM profiles and roles can take some time to propagate in AWS:
 	//  http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/iam-roles-for-amazon-ec2.html#launch-instance-with-role-console
 	// Error creating Lambda function: InvalidParameterValueException: The
@@ -547,6 +574,24 @@ func resourceEventSourceMappingRead(ctx context.Context, d *schema.ResourceData,
 	} else {
 		d.Set("filter_criteria", nil)
 	}
+	if v, ok := d.GetOk("filter_test"); ok {
+		sourceType := eventSourceMappingSourceType(aws.ToString(output.EventSourceArn), output.SelfManagedEventSource != nil)
+		var patterns []string
+		if output.FilterCriteria != nil {
+			patterns = filterCriteriaPatterns([]interface{}{flattenFilterCriteria(output.FilterCriteria)})
+		}
+
+		results, err := testFilterPatterns(sourceType, patterns, v.([]interface{}))
+
+		// A failing test must not prevent the mapping from being refreshed.
+		if err != nil {
+			diags = sdkdiag.AppendWarningf(diags, "testing Lambda Event Source Mapping (%s) filter patterns: %s", d.Id(), err)
+		}
+
+		d.Set("filter_test_results", results)
+	} else {
+		d.Set("filter_test_results", nil)
+	}
 	d.Set(names.AttrFunctionARN, output.FunctionArn)
 	d.Set("function_name", output.FunctionArn)
 	d.Set("function_response_types", output.FunctionResponseTypes)
@@ -650,6 +695,8 @@ func resourceEventSourceMappingUpdate(ctx context.Context, d *schema.ResourceDat
 			// AWS ignores the removal if this is left as nil.
 			input.FilterCriteria = &awstypes.FilterCriteria{}
 		}
+
+		diags = append(diags, filterCriteriaWarnings(d)...)
 	}
 
 	if d.HasChange("function_name") {
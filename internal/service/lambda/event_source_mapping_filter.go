@@ -0,0 +1,639 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+)
+
+// Event filtering is described in https://docs.aws.amazon.com/lambda/latest/dg/invocation-eventfiltering.html.
+
+type filterFieldKind int
+
+const (
+	filterFieldScalar filterFieldKind = iota // Matched with an array of rules.
+	filterFieldObject                        // Matched with a nested pattern.
+	filterFieldData                          // Record payload, matched with either.
+)
+
+// filterFields are the top-level record fields that can be filtered on, by event source ARN service.
+var filterFields = map[string]map[string]filterFieldKind{
+	"dynamodb": {
+		"awsRegion":      filterFieldScalar,
+		"dynamodb":       filterFieldObject,
+		"eventID":        filterFieldScalar,
+		"eventName":      filterFieldScalar,
+		"eventSource":    filterFieldScalar,
+		"eventSourceARN": filterFieldScalar,
+		"eventVersion":   filterFieldScalar,
+		"userIdentity":   filterFieldObject,
+	},
+	"kafka": {
+		"headers":       filterFieldScalar,
+		"key":           filterFieldScalar,
+		"offset":        filterFieldScalar,
+		"partition":     filterFieldScalar,
+		"timestamp":     filterFieldScalar,
+		"timestampType": filterFieldScalar,
+		"topic":         filterFieldScalar,
+		"value":         filterFieldData,
+	},
+	"kinesis": {
+		"approximateArrivalTimestamp": filterFieldScalar,
+		"data":                        filterFieldData,
+		"kinesisSchemaVersion":        filterFieldScalar,
+		"partitionKey":                filterFieldScalar,
+		"sequenceNumber":              filterFieldScalar,
+	},
+	"mq": {
+		"basicProperties": filterFieldObject,
+		"brokerInTime":    filterFieldScalar,
+		"brokerOutTime":   filterFieldScalar,
+		"correlationID":   filterFieldScalar,
+		"data":            filterFieldData,
+		"deliveryMode":    filterFieldScalar,
+		"destination":     filterFieldObject,
+		"expiration":      filterFieldScalar,
+		"messageID":       filterFieldScalar,
+		"messageType":     filterFieldScalar,
+		"priority":        filterFieldScalar,
+		"properties":      filterFieldObject,
+		"redelivered":     filterFieldScalar,
+		"replyTo":         filterFieldScalar,
+		"timestamp":       filterFieldScalar,
+		"type":            filterFieldScalar,
+	},
+	"sqs": {
+		"attributes":             filterFieldObject,
+		"awsRegion":              filterFieldScalar,
+		"body":                   filterFieldData,
+		"eventSource":            filterFieldScalar,
+		"eventSourceARN":         filterFieldScalar,
+		"md5OfBody":              filterFieldScalar,
+		"md5OfMessageAttributes": filterFieldScalar,
+		"messageAttributes":      filterFieldObject,
+		"messageId":              filterFieldScalar,
+		"receiptHandle":          filterFieldScalar,
+	},
+}
+
+// filterDataFields are the record payload fields that Lambda decodes before filtering, by event source ARN service.
+// The SQS message body is plain text, all others are base64-encoded.
+var filterDataFields = map[string]string{
+	"kafka":   "value",
+	"kinesis": "data",
+	"mq":      "data",
+	"sqs":     "body",
+}
+
+// eventSourceMappingSourceType returns the ARN service of an event source mapping's event source.
+func eventSourceMappingSourceType(eventSourceARN string, selfManaged bool) string {
+	if selfManaged {
+		return "kafka"
+	}
+
+	if v, err := arn.Parse(eventSourceARN); err == nil {
+		return v.Service
+	}
+
+	return ""
+}
+
+func customizeDiffEventSourceMappingFilter(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	plan := d.GetRawPlan()
+
+	if !plan.GetAttr("event_source_arn").IsWhollyKnown() || !plan.GetAttr("filter_criteria").IsWhollyKnown() || !plan.GetAttr("filter_test").IsWhollyKnown() {
+		if _, ok := d.GetOk("filter_test"); ok {
+			return d.SetNewComputed("filter_test_results")
+		}
+
+		return nil
+	}
+
+	sourceType := eventSourceMappingSourceType(d.Get("event_source_arn").(string), len(d.Get("self_managed_event_source").([]interface{})) > 0)
+	patterns := filterCriteriaPatterns(d.Get("filter_criteria").([]interface{}))
+
+	// Only validate new or changed patterns so that existing mappings with patterns already accepted by AWS keep planning.
+	if d.Id() == "" || d.HasChange("filter_criteria") {
+		for _, pattern := range patterns {
+			if err := validateFilterPattern(pattern); err != nil {
+				return fmt.Errorf("filter_criteria pattern %s: %w", pattern, err)
+			}
+
+			if err := validateFilterPatternFieldKinds(sourceType, pattern); err != nil {
+				return fmt.Errorf("filter_criteria pattern %s: %w", pattern, err)
+			}
+		}
+	}
+
+	v, ok := d.GetOk("filter_test")
+	if !ok {
+		if len(d.Get("filter_test_results").([]interface{})) > 0 {
+			return d.SetNew("filter_test_results", nil)
+		}
+
+		return nil
+	}
+
+	results, err := testFilterPatterns(sourceType, patterns, v.([]interface{}))
+
+	if err != nil {
+		return err
+	}
+
+	return d.SetNew("filter_test_results", results)
+}
+
+// filterCriteriaPatterns returns the patterns of a filter_criteria configuration block.
+func filterCriteriaPatterns(tfList []interface{}) []string {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	var patterns []string
+
+	if apiObject := expandFilterCriteria(tfList[0].(map[string]interface{})); apiObject != nil {
+		for _, filter := range apiObject.Filters {
+			if v := filter.Pattern; v != nil && *v != "" {
+				patterns = append(patterns, *v)
+			}
+		}
+	}
+
+	return patterns
+}
+
+// testFilterPatterns runs the sample records of a filter_test configuration block through the filter patterns and
+// reports whether each would be delivered to the function.
+func testFilterPatterns(sourceType string, patterns []string, tfList []interface{}) ([]interface{}, error) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil, nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	results := []interface{}{}
+
+	for i, v := range tfMap["records"].([]interface{}) {
+		record, _ := v.(string)
+		delivered, err := filterPatternsMatch(sourceType, patterns, record)
+
+		if err != nil {
+			return nil, fmt.Errorf("filter_test record %d: %w", i, err)
+		}
+
+		results = append(results, delivered)
+	}
+
+	return results, nil
+}
+
+// validateFilterPattern validates a filter pattern's grammar.
+func validateFilterPattern(pattern string) error {
+	var v interface{}
+
+	if err := json.Unmarshal([]byte(pattern), &v); err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	tfMap, ok := v.(map[string]interface{})
+
+	if !ok || len(tfMap) == 0 {
+		return errors.New("must be a non-empty JSON object")
+	}
+
+	return validateFilterPatternObject(tfMap, "")
+}
+
+// filterCriteriaWarnings returns a warning for each filter_criteria pattern that filters on a top-level field that the
+// records of a known event source type don't have.
+// AWS accepts such patterns, but they never match a record.
+func filterCriteriaWarnings(d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	sourceType := eventSourceMappingSourceType(d.Get("event_source_arn").(string), len(d.Get("self_managed_event_source").([]interface{})) > 0)
+
+	for _, pattern := range filterCriteriaPatterns(d.Get("filter_criteria").([]interface{})) {
+		if err := validateFilterPatternFields(sourceType, pattern); err != nil {
+			diags = sdkdiag.AppendWarningf(diags, "filter_criteria pattern %s: %s. No records will match the pattern", pattern, err)
+		}
+	}
+
+	return diags
+}
+
+// validateFilterPatternFields validates, for known event source types, that a filter pattern's top-level fields are
+// record fields.
+func validateFilterPatternFields(sourceType, pattern string) error {
+	tfMap, fields, ok := filterPatternFields(sourceType, pattern)
+
+	if !ok {
+		return nil
+	}
+
+	keys := tfmaps.Keys(tfMap)
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		if _, ok := fields[key]; !ok {
+			keys := tfmaps.Keys(fields)
+			slices.Sort(keys)
+
+			return fmt.Errorf("%q is not a filterable %s record field, expected one of: %s", key, sourceType, strings.Join(keys, ", "))
+		}
+	}
+
+	return nil
+}
+
+// validateFilterPatternFieldKinds validates, for known event source types, that a filter pattern matches each known
+// top-level field with a nested pattern or an array of rules as the field's values require.
+func validateFilterPatternFieldKinds(sourceType, pattern string) error {
+	tfMap, fields, ok := filterPatternFields(sourceType, pattern)
+
+	if !ok {
+		return nil
+	}
+
+	keys := tfmaps.Keys(tfMap)
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		kind, ok := fields[key]
+
+		// Unknown fields are reported by validateFilterPatternFields.
+		if !ok {
+			continue
+		}
+
+		_, nested := tfMap[key].(map[string]interface{})
+
+		switch kind {
+		case filterFieldObject:
+			if !nested {
+				return fmt.Errorf("%q must be matched with a nested pattern", key)
+			}
+		case filterFieldScalar:
+			if nested {
+				return fmt.Errorf("%q must be matched with an array of rules", key)
+			}
+		}
+	}
+
+	return nil
+}
+
+// filterPatternFields returns a filter pattern's top-level fields and the record fields of a known event source type.
+func filterPatternFields(sourceType, pattern string) (map[string]interface{}, map[string]filterFieldKind, bool) {
+	fields, ok := filterFields[sourceType]
+
+	if !ok {
+		return nil, nil, false
+	}
+
+	var tfMap map[string]interface{}
+
+	// Invalid patterns are reported by validateFilterPattern.
+	if err := json.Unmarshal([]byte(pattern), &tfMap); err != nil {
+		return nil, nil, false
+	}
+
+	return tfMap, fields, true
+}
+
+func validateFilterPatternObject(tfMap map[string]interface{}, path string) error {
+	keys := tfmaps.Keys(tfMap)
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		path := path + key
+
+		switch v := tfMap[key].(type) {
+		case map[string]interface{}:
+			if len(v) == 0 {
+				return fmt.Errorf("%q: nested pattern must not be empty", path)
+			}
+
+			if err := validateFilterPatternObject(v, path+"."); err != nil {
+				return err
+			}
+		case []interface{}:
+			if len(v) == 0 {
+				return fmt.Errorf("%q: array of rules must not be empty", path)
+			}
+
+			for _, rule := range v {
+				if err := validateFilterRule(rule); err != nil {
+					return fmt.Errorf("%q: %w", path, err)
+				}
+			}
+		default:
+			return fmt.Errorf("%q: must be an array of rules or a nested pattern, got %s", path, jsonString(v))
+		}
+	}
+
+	return nil
+}
+
+func validateFilterRule(rule interface{}) error {
+	switch rule := rule.(type) {
+	case nil, bool, float64, string:
+		return nil
+	case map[string]interface{}:
+		if len(rule) != 1 {
+			return fmt.Errorf("rule %s must have exactly one operator", jsonString(rule))
+		}
+
+		for operator, operand := range rule {
+			switch operator {
+			case "equals-ignore-case", "prefix", "suffix":
+				if _, ok := operand.(string); !ok {
+					return fmt.Errorf("%q operand must be a string", operator)
+				}
+			case "anything-but":
+				return validateAnythingButOperand(operand)
+			case "exists":
+				if _, ok := operand.(bool); !ok {
+					return errors.New(`"exists" operand must be a boolean`)
+				}
+			case "numeric":
+				return validateNumericOperand(operand)
+			default:
+				return fmt.Errorf("unsupported operator %q", operator)
+			}
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("invalid rule %s", jsonString(rule))
+	}
+}
+
+func validateAnythingButOperand(operand interface{}) error {
+	switch v := operand.(type) {
+	case bool, float64, string:
+		return nil
+	case []interface{}:
+		if len(v) == 0 {
+			return errors.New(`"anything-but" operand must not be empty`)
+		}
+
+		for _, v := range v {
+			switch v.(type) {
+			case bool, float64, string:
+			default:
+				return fmt.Errorf(`"anything-but" operand values must be scalars, got %s`, jsonString(v))
+			}
+		}
+
+		return nil
+	case map[string]interface{}:
+		if prefix, ok := v["prefix"].(string); len(v) == 1 && ok && prefix != "" {
+			return nil
+		}
+	}
+
+	return fmt.Errorf(`invalid "anything-but" operand %s`, jsonString(operand))
+}
+
+func validateNumericOperand(operand interface{}) error {
+	tfList, ok := operand.([]interface{})
+
+	if !ok || (len(tfList) != 2 && len(tfList) != 4) {
+		return fmt.Errorf(`"numeric" operand %s must be an array of one or two comparisons`, jsonString(operand))
+	}
+
+	var operators []string
+	var values []float64
+
+	for i := 0; i < len(tfList); i += 2 {
+		operator, ok := tfList[i].(string)
+
+		if !ok || !slices.Contains([]string{"<", "<=", "=", ">", ">="}, operator) {
+			return fmt.Errorf(`"numeric" operator %s must be one of "<", "<=", "=", ">" or ">="`, jsonString(tfList[i]))
+		}
+
+		value, ok := tfList[i+1].(float64)
+
+		if !ok {
+			return fmt.Errorf(`"numeric" value %s must be a number`, jsonString(tfList[i+1]))
+		}
+
+		operators = append(operators, operator)
+		values = append(values, value)
+	}
+
+	if len(operators) == 2 {
+		if !slices.Contains([]string{">", ">="}, operators[0]) || !slices.Contains([]string{"<", "<="}, operators[1]) || values[0] >= values[1] {
+			return fmt.Errorf(`"numeric" range %s must be a lower bound followed by a greater upper bound`, jsonString(operand))
+		}
+	}
+
+	return nil
+}
+
+// filterPatternsMatch reports whether a JSON record matches any of the filter patterns.
+// A record is delivered to the function when there are no patterns.
+func filterPatternsMatch(sourceType string, patterns []string, record string) (bool, error) {
+	var v interface{}
+
+	if err := json.Unmarshal([]byte(record), &v); err != nil {
+		return false, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	tfMap, ok := v.(map[string]interface{})
+
+	if !ok {
+		return false, errors.New("must be a JSON object")
+	}
+
+	if len(patterns) == 0 {
+		return true, nil
+	}
+
+	tfMap = decodeFilterRecord(sourceType, tfMap)
+
+	for _, pattern := range patterns {
+		var v map[string]interface{}
+
+		if err := json.Unmarshal([]byte(pattern), &v); err != nil {
+			return false, fmt.Errorf("pattern %s: %w", pattern, err)
+		}
+
+		if matchFilterPattern(v, tfMap) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// decodeFilterRecord decodes a record's payload the way Lambda does before filtering.
+// A payload that is valid JSON is matched as JSON. Otherwise, an SQS message body is matched as a plain string
+// and other payloads can't be matched at all.
+func decodeFilterRecord(sourceType string, tfMap map[string]interface{}) map[string]interface{} {
+	key, ok := filterDataFields[sourceType]
+
+	if !ok {
+		return tfMap
+	}
+
+	data, ok := tfMap[key].(string)
+
+	if !ok {
+		return tfMap
+	}
+
+	record := make(map[string]interface{}, len(tfMap))
+	for k, v := range tfMap {
+		record[k] = v
+	}
+
+	if sourceType != "sqs" {
+		delete(record, key)
+
+		v, err := base64.StdEncoding.DecodeString(data)
+
+		if err != nil {
+			return record
+		}
+
+		data = string(v)
+	}
+
+	var v interface{}
+
+	if err := json.Unmarshal([]byte(data), &v); err == nil {
+		record[key] = v
+	}
+
+	return record
+}
+
+func matchFilterPattern(pattern, tfMap map[string]interface{}) bool {
+	for key, v := range pattern {
+		value, exists := tfMap[key]
+
+		switch v := v.(type) {
+		case map[string]interface{}:
+			if value, ok := value.(map[string]interface{}); !ok || !matchFilterPattern(v, value) {
+				return false
+			}
+		case []interface{}:
+			if !slices.ContainsFunc(v, func(rule interface{}) bool { return matchFilterRule(rule, value, exists) }) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchFilterRule(rule, value interface{}, exists bool) bool {
+	if rule, ok := rule.(map[string]interface{}); ok {
+		if v, ok := rule["exists"].(bool); ok {
+			return v == exists
+		}
+	}
+
+	if !exists {
+		return false
+	}
+
+	// A rule matches an array if it matches any of its elements.
+	if value, ok := value.([]interface{}); ok {
+		return slices.ContainsFunc(value, func(value interface{}) bool { return matchFilterRule(rule, value, true) })
+	}
+
+	switch rule := rule.(type) {
+	case nil:
+		return value == nil
+	case bool, float64, string:
+		return rule == value
+	case map[string]interface{}:
+		for operator, operand := range rule {
+			s, isString := value.(string)
+
+			switch operator {
+			case "anything-but":
+				switch operand := operand.(type) {
+				case []interface{}:
+					return !slices.ContainsFunc(operand, func(operand interface{}) bool { return operand == value })
+				case map[string]interface{}:
+					prefix, _ := operand["prefix"].(string)
+					return isString && !strings.HasPrefix(s, prefix)
+				default:
+					return operand != value
+				}
+			case "equals-ignore-case":
+				operand, _ := operand.(string)
+				return isString && strings.EqualFold(s, operand)
+			case "numeric":
+				return matchNumericRule(operand, value)
+			case "prefix":
+				operand, _ := operand.(string)
+				return isString && strings.HasPrefix(s, operand)
+			case "suffix":
+				operand, _ := operand.(string)
+				return isString && strings.HasSuffix(s, operand)
+			}
+		}
+	}
+
+	return false
+}
+
+func matchNumericRule(operand, value interface{}) bool {
+	n, ok := value.(float64)
+
+	if !ok {
+		return false
+	}
+
+	tfList, _ := operand.([]interface{})
+
+	for i := 0; i+1 < len(tfList); i += 2 {
+		operator, _ := tfList[i].(string)
+		v, _ := tfList[i+1].(float64)
+
+		var ok bool
+		switch operator {
+		case "<":
+			ok = n < v
+		case "<=":
+			ok = n <= v
+		case "=":
+			ok = n == v
+		case ">":
+			ok = n > v
+		case ">=":
+			ok = n >= v
+		}
+
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func jsonString(v interface{}) string {
+	b, _ := json.Marshal(v)
+
+	return string(b)
+}
@@ -0,0 +1,463 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestValidateFilterPattern(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pattern string
+		wantErr string
+	}{
+		"valid": {
+			pattern: `{"body": {"Region": [{"prefix": "us-"}], "Count": [{"numeric": [">", 0, "<=", 5]}]}}`,
+		},
+		"any field": {
+			pattern: `{"anything": {"goes": [null, ""]}}`,
+		},
+		"invalid JSON": {
+			pattern: `{"body": `,
+			wantErr: "invalid JSON",
+		},
+		"array": {
+			pattern: `[]`,
+			wantErr: "must be a non-empty JSON object",
+		},
+		"empty object": {
+			pattern: `{}`,
+			wantErr: "must be a non-empty JSON object",
+		},
+		"scalar value": {
+			pattern: `{"body": {"Region": "us-east-1"}}`,
+			wantErr: `"body.Region": must be an array of rules or a nested pattern`,
+		},
+		"empty rules": {
+			pattern: `{"a": []}`,
+			wantErr: `"a": array of rules must not be empty`,
+		},
+		"empty nested pattern": {
+			pattern: `{"a": {}}`,
+			wantErr: `"a": nested pattern must not be empty`,
+		},
+		"unsupported operator": {
+			pattern: `{"a": [{"wildcard": "*"}]}`,
+			wantErr: `unsupported operator "wildcard"`,
+		},
+		"multiple operators": {
+			pattern: `{"a": [{"prefix": "x", "suffix": "y"}]}`,
+			wantErr: "must have exactly one operator",
+		},
+		"prefix not string": {
+			pattern: `{"a": [{"prefix": 1}]}`,
+			wantErr: `"prefix" operand must be a string`,
+		},
+		"exists not boolean": {
+			pattern: `{"a": [{"exists": "true"}]}`,
+			wantErr: `"exists" operand must be a boolean`,
+		},
+		"anything-but prefix": {
+			pattern: `{"a": [{"anything-but": {"prefix": "x"}}]}`,
+		},
+		"anything-but empty": {
+			pattern: `{"a": [{"anything-but": []}]}`,
+			wantErr: `"anything-but" operand must not be empty`,
+		},
+		"numeric equals": {
+			pattern: `{"a": [{"numeric": ["=", 5]}]}`,
+		},
+		"numeric invalid operator": {
+			pattern: `{"a": [{"numeric": ["!=", 5]}]}`,
+			wantErr: `"numeric" operator "!=" must be one of`,
+		},
+		"numeric not number": {
+			pattern: `{"a": [{"numeric": [">", "5"]}]}`,
+			wantErr: `"numeric" value "5" must be a number`,
+		},
+		"numeric inverted range": {
+			pattern: `{"a": [{"numeric": ["<", 5, ">", 0]}]}`,
+			wantErr: `"numeric" range [`,
+		},
+		"nested array rule": {
+			pattern: `{"a": [["x"]]}`,
+			wantErr: `invalid rule [`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateFilterPattern(testCase.pattern)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error containing %q", testCase.wantErr)
+			}
+
+			if !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Errorf("error %q does not contain %q", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateFilterPatternFields(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		sourceType string
+		pattern    string
+		wantErr    string
+	}{
+		"SQS body": {
+			sourceType: "sqs",
+			pattern:    `{"body": {"Region": [{"prefix": "us-"}], "Count": [{"numeric": [">", 0, "<=", 5]}]}}`,
+		},
+		"SQS plain text body": {
+			sourceType: "sqs",
+			pattern:    `{"body": [{"prefix": "ERROR"}]}`,
+		},
+		"SQS message attributes": {
+			sourceType: "sqs",
+			pattern:    `{"messageAttributes": {"Type": {"stringValue": ["order"]}}}`,
+		},
+		"SQS unknown field": {
+			sourceType: "sqs",
+			pattern:    `{"Region": [{"prefix": "us-"}]}`,
+			wantErr:    `"Region" is not a filterable sqs record field`,
+		},
+		"SQS attributes without nested pattern": {
+			sourceType: "sqs",
+			pattern:    `{"attributes": ["x"]}`,
+		},
+		"Kinesis data": {
+			sourceType: "kinesis",
+			pattern:    `{"data": {"temperature": [{"numeric": [">=", 30]}]}, "partitionKey": ["1"]}`,
+		},
+		"Kinesis SQS field": {
+			sourceType: "kinesis",
+			pattern:    `{"body": {"temperature": [{"numeric": [">=", 30]}]}}`,
+			wantErr:    `"body" is not a filterable kinesis record field`,
+		},
+		"DynamoDB new image": {
+			sourceType: "dynamodb",
+			pattern:    `{"eventName": ["INSERT"], "dynamodb": {"NewImage": {"Status": {"S": [{"anything-but": ["DELETED"]}]}}}}`,
+		},
+		"Kafka value": {
+			sourceType: "kafka",
+			pattern:    `{"value": {"level": [{"equals-ignore-case": "error"}]}, "topic": [{"suffix": "-logs"}]}`,
+		},
+		"MQ data": {
+			sourceType: "mq",
+			pattern:    `{"data": {"id": [{"exists": true}]}, "redelivered": [false]}`,
+		},
+		"unknown source type": {
+			pattern: `{"anything": {"goes": [null, ""]}}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateFilterPatternFields(testCase.sourceType, testCase.pattern)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error containing %q", testCase.wantErr)
+			}
+
+			if !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Errorf("error %q does not contain %q", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateFilterPatternFieldKinds(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		sourceType string
+		pattern    string
+		wantErr    string
+	}{
+		"SQS body": {
+			sourceType: "sqs",
+			pattern:    `{"body": {"Region": [{"prefix": "us-"}]}}`,
+		},
+		"SQS plain text body": {
+			sourceType: "sqs",
+			pattern:    `{"body": [{"prefix": "ERROR"}]}`,
+		},
+		"SQS attributes without nested pattern": {
+			sourceType: "sqs",
+			pattern:    `{"attributes": ["x"]}`,
+			wantErr:    `"attributes" must be matched with a nested pattern`,
+		},
+		"SQS unknown field": {
+			sourceType: "sqs",
+			pattern:    `{"Region": {"Name": ["us-east-1"]}}`,
+		},
+		"DynamoDB new image": {
+			sourceType: "dynamodb",
+			pattern:    `{"eventName": ["INSERT"], "dynamodb": {"NewImage": {"Status": {"S": [{"anything-but": ["DELETED"]}]}}}}`,
+		},
+		"DynamoDB partition key without nested pattern": {
+			sourceType: "dynamodb",
+			pattern:    `{"dynamodb": ["x"]}`,
+			wantErr:    `"dynamodb" must be matched with a nested pattern`,
+		},
+		"DynamoDB event name with nested pattern": {
+			sourceType: "dynamodb",
+			pattern:    `{"eventName": {"S": ["INSERT"]}}`,
+			wantErr:    `"eventName" must be matched with an array of rules`,
+		},
+		"Kinesis data": {
+			sourceType: "kinesis",
+			pattern:    `{"data": [{"prefix": "ERROR"}], "partitionKey": ["1"]}`,
+		},
+		"unknown source type": {
+			pattern: `{"eventName": {"S": ["INSERT"]}}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateFilterPatternFieldKinds(testCase.sourceType, testCase.pattern)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error containing %q", testCase.wantErr)
+			}
+
+			if !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Errorf("error %q does not contain %q", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestFilterPatternsMatch(t *testing.T) {
+	t.Parallel()
+
+	base64JSON := func(v string) string {
+		return base64.StdEncoding.EncodeToString([]byte(v))
+	}
+
+	testCases := map[string]struct {
+		sourceType string
+		patterns   []string
+		record     string
+		want       bool
+		wantErr    bool
+	}{
+		"no patterns": {
+			sourceType: "sqs",
+			record:     `{"body": "anything"}`,
+			want:       true,
+		},
+		"SQS JSON body": {
+			sourceType: "sqs",
+			patterns:   []string{`{"body": {"Region": [{"prefix": "us-"}]}}`},
+			record:     `{"body": "{\"Region\": \"us-east-1\"}"}`,
+			want:       true,
+		},
+		"SQS JSON body not matching": {
+			sourceType: "sqs",
+			patterns:   []string{`{"body": {"Region": [{"prefix": "us-"}]}}`},
+			record:     `{"body": "{\"Region\": \"eu-west-1\"}"}`,
+		},
+		"SQS plain text body with nested pattern": {
+			sourceType: "sqs",
+			patterns:   []string{`{"body": {"Region": [{"prefix": "us-"}]}}`},
+			record:     `{"body": "Region us-east-1"}`,
+		},
+		"SQS plain text body": {
+			sourceType: "sqs",
+			patterns:   []string{`{"body": [{"prefix": "Region us-"}]}`},
+			record:     `{"body": "Region us-east-1"}`,
+			want:       true,
+		},
+		"any pattern": {
+			sourceType: "sqs",
+			patterns:   []string{`{"body": {"a": ["x"]}}`, `{"body": {"b": ["y"]}}`},
+			record:     `{"body": "{\"b\": \"y\"}"}`,
+			want:       true,
+		},
+		"all fields": {
+			patterns: []string{`{"a": ["x"], "b": ["y"]}`},
+			record:   `{"a": "x", "b": "z"}`,
+		},
+		"any rule": {
+			patterns: []string{`{"a": ["x", "y"]}`},
+			record:   `{"a": "y"}`,
+			want:     true,
+		},
+		"array value": {
+			patterns: []string{`{"a": ["y"]}`},
+			record:   `{"a": ["x", "y"]}`,
+			want:     true,
+		},
+		"null": {
+			patterns: []string{`{"a": [null]}`},
+			record:   `{"a": null}`,
+			want:     true,
+		},
+		"null missing field": {
+			patterns: []string{`{"a": [null]}`},
+			record:   `{}`,
+		},
+		"empty string": {
+			patterns: []string{`{"a": [""]}`},
+			record:   `{"a": ""}`,
+			want:     true,
+		},
+		"number": {
+			patterns: []string{`{"a": [5]}`},
+			record:   `{"a": 5.0}`,
+			want:     true,
+		},
+		"number does not match string": {
+			patterns: []string{`{"a": [5]}`},
+			record:   `{"a": "5"}`,
+		},
+		"boolean": {
+			patterns: []string{`{"a": [false]}`},
+			record:   `{"a": false}`,
+			want:     true,
+		},
+		"suffix": {
+			patterns: []string{`{"a": [{"suffix": ".png"}]}`},
+			record:   `{"a": "image.png"}`,
+			want:     true,
+		},
+		"equals-ignore-case": {
+			patterns: []string{`{"a": [{"equals-ignore-case": "ERROR"}]}`},
+			record:   `{"a": "Error"}`,
+			want:     true,
+		},
+		"anything-but": {
+			patterns: []string{`{"a": [{"anything-but": ["x", "y"]}]}`},
+			record:   `{"a": "z"}`,
+			want:     true,
+		},
+		"anything-but excluded": {
+			patterns: []string{`{"a": [{"anything-but": "x"}]}`},
+			record:   `{"a": "x"}`,
+		},
+		"anything-but missing field": {
+			patterns: []string{`{"a": [{"anything-but": "x"}]}`},
+			record:   `{}`,
+		},
+		"anything-but prefix": {
+			patterns: []string{`{"a": [{"anything-but": {"prefix": "tmp-"}}]}`},
+			record:   `{"a": "tmp-1"}`,
+		},
+		"numeric range": {
+			patterns: []string{`{"a": [{"numeric": [">", 0, "<=", 5]}]}`},
+			record:   `{"a": 5}`,
+			want:     true,
+		},
+		"numeric out of range": {
+			patterns: []string{`{"a": [{"numeric": [">", 0, "<=", 5]}]}`},
+			record:   `{"a": 6}`,
+		},
+		"exists": {
+			patterns: []string{`{"a": [{"exists": true}]}`},
+			record:   `{"a": "x"}`,
+			want:     true,
+		},
+		"does not exist": {
+			patterns: []string{`{"a": [{"exists": false}]}`},
+			record:   `{"b": "x"}`,
+			want:     true,
+		},
+		"nested missing": {
+			patterns: []string{`{"a": {"b": ["x"]}}`},
+			record:   `{"a": "x"}`,
+		},
+		"Kinesis data": {
+			sourceType: "kinesis",
+			patterns:   []string{`{"data": {"temperature": [{"numeric": [">=", 30]}]}}`},
+			record:     fmt.Sprintf(`{"partitionKey": "1", "data": %q}`, base64JSON(`{"temperature": 32}`)),
+			want:       true,
+		},
+		"Kinesis non-JSON data": {
+			sourceType: "kinesis",
+			patterns:   []string{`{"data": [{"prefix": "temp"}]}`},
+			record:     fmt.Sprintf(`{"partitionKey": "1", "data": %q}`, base64JSON(`temperature 32`)),
+		},
+		"Kinesis metadata with non-JSON data": {
+			sourceType: "kinesis",
+			patterns:   []string{`{"partitionKey": ["1"]}`},
+			record:     fmt.Sprintf(`{"partitionKey": "1", "data": %q}`, base64JSON(`temperature 32`)),
+			want:       true,
+		},
+		"DynamoDB": {
+			sourceType: "dynamodb",
+			patterns:   []string{`{"eventName": ["INSERT"], "dynamodb": {"NewImage": {"Status": {"S": ["ACTIVE"]}}}}`},
+			record:     `{"eventName": "INSERT", "dynamodb": {"NewImage": {"Status": {"S": "ACTIVE"}}}}`,
+			want:       true,
+		},
+		"invalid record": {
+			record:  `not JSON`,
+			wantErr: true,
+		},
+		"record not object": {
+			record:  `["x"]`,
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := filterPatternsMatch(testCase.sourceType, testCase.patterns, testCase.record)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("filterPatternsMatch() err %t, want %t: %v", got, want, err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("filterPatternsMatch() = %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
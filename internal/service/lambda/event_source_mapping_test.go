@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/kafka"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
@@ -1146,6 +1147,52 @@ func TestAccLambdaEventSourceMapping_SQS_filterCriteria(t *testing.T) {
 	})
 }
 
+func TestAccLambdaEventSourceMapping_SQS_filterTest(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf lambda.GetEventSourceMappingOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_event_source_mapping.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventSourceMappingDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEventSourceMappingConfig_sqsFilterCriteria1(rName, `{"body": {"Region": "us-east-1"}}`),
+				ExpectError: regexache.MustCompile(`"body.Region": must be an array of rules or a nested pattern`),
+			},
+			{
+				Config:      testAccEventSourceMappingConfig_sqsFilterCriteria1(rName, `{"attributes": ["x"]}`),
+				ExpectError: regexache.MustCompile(`"attributes" must be matched with a nested pattern`),
+			},
+			{
+				Config: testAccEventSourceMappingConfig_sqsFilterTest(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventSourceMappingExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "filter_test.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "filter_test.0.records.#", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, "filter_test_results.#", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, "filter_test_results.0", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "filter_test_results.1", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "filter_test_results.2", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filter_test", "filter_test_results", "last_modified"},
+			},
+		},
+	})
+}
+
 func TestAccLambdaEventSourceMapping_SQS_scalingConfig(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -2569,6 +2616,33 @@ resource "aws_lambda_event_source_mapping" "test" {
 `)
 }
 
+func testAccEventSourceMappingConfig_sqsFilterTest(rName string) string {
+	return acctest.ConfigCompose(testAccEventSourceMappingConfig_sqsBase(rName), `
+resource "aws_lambda_event_source_mapping" "test" {
+  event_source_arn = aws_sqs_queue.test.arn
+  function_name    = aws_lambda_function.test.arn
+
+  filter_criteria {
+    filter {
+      pattern = jsonencode({
+        body = {
+          Region = [{ prefix = "us-" }]
+        }
+      })
+    }
+  }
+
+  filter_test {
+    records = [
+      jsonencode({ body = jsonencode({ Region = "us-east-1" }) }),
+      jsonencode({ body = jsonencode({ Region = "eu-west-1" }) }),
+      jsonencode({ body = "Region us-east-1" }),
+    ]
+  }
+}
+`)
+}
+
 func testAccEventSourceMappingConfig_sqsScalingConfig1(rName string, maximumConcurrency int) string {
 	return acctest.ConfigCompose(testAccEventSourceMappingConfig_sqsBase(rName), fmt.Sprintf(`
 resource "aws_lambda_event_source_mapping" "test" {
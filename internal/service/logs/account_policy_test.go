@@ -109,6 +109,69 @@ func TestAccLogsAccountPolicy_basicDataProtection(t *testing.T) {
 	})
 }
 
+func TestAccLogsAccountPolicy_basicFieldIndex(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_account_policy.test"
+	var accountPolicy types.AccountPolicy
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountPolicyConfig_basicFieldIndex(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountPolicyExists(ctx, resourceName, &accountPolicy),
+					resource.TestCheckResourceAttr(resourceName, "policy_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", "FIELD_INDEX_POLICY"),
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "policy_document", `{"Fields":["RequestId","TransactionId"]}`),
+					resource.TestCheckResourceAttr(resourceName, "selection_criteria", fmt.Sprintf("LogGroupNamePrefix IN [\"%s\"]", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccAccountPolicyImportStateIDFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLogsAccountPolicy_basicTransformer(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_account_policy.test"
+	var accountPolicy types.AccountPolicy
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountPolicyConfig_basicTransformer(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountPolicyExists(ctx, resourceName, &accountPolicy),
+					resource.TestCheckResourceAttr(resourceName, "policy_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", "TRANSFORMER_POLICY"),
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "policy_document", `{"transformerConfig":[{"parseJSON":{}}]}`),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccAccountPolicyImportStateIDFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccLogsAccountPolicy_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -360,3 +423,35 @@ resource "aws_cloudwatch_log_account_policy" "test" {
 }
 `, rName)
 }
+
+func testAccAccountPolicyConfig_basicFieldIndex(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_account_policy" "test" {
+  policy_name = %[1]q
+  policy_type = "FIELD_INDEX_POLICY"
+
+  policy_document = jsonencode({
+    Fields = ["RequestId", "TransactionId"]
+  })
+
+  selection_criteria = "LogGroupNamePrefix IN [\"%[1]s\"]"
+}
+`, rName)
+}
+
+func testAccAccountPolicyConfig_basicTransformer(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_account_policy" "test" {
+  policy_name = %[1]q
+  policy_type = "TRANSFORMER_POLICY"
+
+  policy_document = jsonencode({
+    transformerConfig = [
+      {
+        parseJSON = {}
+      }
+    ]
+  })
+}
+`, rName)
+}
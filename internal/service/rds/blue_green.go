@@ -62,10 +62,13 @@ func (o *blueGreenOrchestrator) waitForDeploymentAvailable(ctx context.Context,
 	return dep, nil
 }
 
-func (o *blueGreenOrchestrator) Switchover(ctx context.Context, identifier string, timeout time.Duration) (*types.BlueGreenDeployment, error) {
+func (o *blueGreenOrchestrator) Switchover(ctx context.Context, identifier string, timeout time.Duration, optFns ...func(*rds_sdkv2.SwitchoverBlueGreenDeploymentInput)) (*types.BlueGreenDeployment, error) {
 	input := &rds_sdkv2.SwitchoverBlueGreenDeploymentInput{
 		BlueGreenDeploymentIdentifier: aws.String(identifier),
 	}
+	for _, fn := range optFns {
+		fn(input)
+	}
 	_, err := tfresource.RetryWhen(ctx, 10*time.Minute,
 		func() (interface{}, error) {
 			return o.conn.SwitchoverBlueGreenDeployment(ctx, input)
@@ -162,3 +165,170 @@ func (h *instanceHandler) modifyTarget(ctx context.Context, identifier string, d
 
 	return nil
 }
+
+type clusterHandler struct {
+	conn *rds_sdkv2.Client
+}
+
+func newClusterHandler(conn *rds_sdkv2.Client) *clusterHandler {
+	return &clusterHandler{
+		conn: conn,
+	}
+}
+
+func (h *clusterHandler) precondition(ctx context.Context, d *schema.ResourceData) error {
+	// Deletion protection is carried over to the Green environment. Set it first.
+	if !d.HasChange(names.AttrDeletionProtection) {
+		return nil
+	}
+
+	input := &rds_sdkv2.ModifyDBClusterInput{
+		ApplyImmediately:    aws.Bool(true),
+		DBClusterIdentifier: aws.String(d.Id()),
+		DeletionProtection:  aws.Bool(d.Get(names.AttrDeletionProtection).(bool)),
+	}
+
+	if _, err := h.conn.ModifyDBCluster(ctx, input); err != nil {
+		return fmt.Errorf("setting pre-conditions: %s", err)
+	}
+
+	if _, err := waitDBClusterUpdated(ctx, h.conn, d.Id(), true, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("setting pre-conditions: waiting for completion: %s", err)
+	}
+
+	return nil
+}
+
+func (h *clusterHandler) createBlueGreenInput(d *schema.ResourceData) *rds_sdkv2.CreateBlueGreenDeploymentInput {
+	input := &rds_sdkv2.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName: aws.String(d.Id()),
+		Source:                  aws.String(d.Get(names.AttrARN).(string)),
+	}
+
+	if d.HasChange(names.AttrEngineVersion) {
+		input.TargetEngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
+	}
+	if d.HasChange("db_cluster_parameter_group_name") {
+		input.TargetDBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
+	}
+	if d.HasChange("db_instance_parameter_group_name") {
+		input.TargetDBParameterGroupName = aws.String(d.Get("db_instance_parameter_group_name").(string))
+	}
+
+	return input
+}
+
+// deleteBlueGreenDeploymentSource deletes the Blue environment of a switched over Blue/Green Deployment.
+// The source is either a DB instance ARN or a DB cluster ARN, in which case the cluster's member instances are deleted first.
+func deleteBlueGreenDeploymentSource(ctx context.Context, conn *rds_sdkv2.Client, source string, timeout time.Duration) error {
+	if clusterARN, err := parseDBClusterARN(source); err == nil {
+		return deleteBlueGreenDeploymentSourceCluster(ctx, conn, clusterARN.Identifier, timeout)
+	}
+
+	instanceARN, err := parseDBInstanceARN(source)
+	if err != nil {
+		return err
+	}
+
+	return deleteBlueGreenDeploymentSourceInstance(ctx, conn, instanceARN.Identifier, timeout)
+}
+
+func deleteBlueGreenDeploymentSourceInstance(ctx context.Context, conn *rds_sdkv2.Client, id string, timeout time.Duration) error {
+	instance, err := findDBInstanceByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading RDS DB Instance (%s): %s", id, err)
+	}
+
+	if aws.ToBool(instance.DeletionProtection) {
+		input := &rds_sdkv2.ModifyDBInstanceInput{
+			ApplyImmediately:     aws.Bool(true),
+			DBInstanceIdentifier: aws.String(id),
+			DeletionProtection:   aws.Bool(false),
+		}
+
+		if err := dbInstanceModify(ctx, conn, id, input, timeout); err != nil {
+			return fmt.Errorf("disabling RDS DB Instance (%s) deletion protection: %s", id, err)
+		}
+	}
+
+	_, err = tfresource.RetryWhenIsA[*types.InvalidDBInstanceStateFault](ctx, timeout, func() (interface{}, error) {
+		return conn.DeleteDBInstance(ctx, &rds_sdkv2.DeleteDBInstanceInput{
+			DBInstanceIdentifier: aws.String(id),
+			SkipFinalSnapshot:    aws.Bool(true),
+		})
+	})
+
+	if errs.IsA[*types.DBInstanceNotFoundFault](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting RDS DB Instance (%s): %s", id, err)
+	}
+
+	if _, err := waitDBInstanceDeleted(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("deleting RDS DB Instance (%s): waiting for completion: %s", id, err)
+	}
+
+	return nil
+}
+
+func deleteBlueGreenDeploymentSourceCluster(ctx context.Context, conn *rds_sdkv2.Client, id string, timeout time.Duration) error {
+	cluster, err := findDBClusterByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading RDS Cluster (%s): %s", id, err)
+	}
+
+	for _, v := range cluster.DBClusterMembers {
+		if err := deleteBlueGreenDeploymentSourceInstance(ctx, conn, aws.ToString(v.DBInstanceIdentifier), timeout); err != nil {
+			return err
+		}
+	}
+
+	if aws.ToBool(cluster.DeletionProtection) {
+		input := &rds_sdkv2.ModifyDBClusterInput{
+			ApplyImmediately:    aws.Bool(true),
+			DBClusterIdentifier: aws.String(id),
+			DeletionProtection:  aws.Bool(false),
+		}
+
+		if _, err := conn.ModifyDBCluster(ctx, input); err != nil {
+			return fmt.Errorf("disabling RDS Cluster (%s) deletion protection: %s", id, err)
+		}
+
+		if _, err := waitDBClusterUpdated(ctx, conn, id, false, timeout); err != nil {
+			return fmt.Errorf("disabling RDS Cluster (%s) deletion protection: waiting for completion: %s", id, err)
+		}
+	}
+
+	_, err = tfresource.RetryWhenIsA[*types.InvalidDBClusterStateFault](ctx, timeout, func() (interface{}, error) {
+		return conn.DeleteDBCluster(ctx, &rds_sdkv2.DeleteDBClusterInput{
+			DBClusterIdentifier: aws.String(id),
+			SkipFinalSnapshot:   aws.Bool(true),
+		})
+	})
+
+	if errs.IsA[*types.DBClusterNotFoundFault](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting RDS Cluster (%s): %s", id, err)
+	}
+
+	if _, err := waitDBClusterDeleted(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("deleting RDS Cluster (%s): waiting for completion: %s", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,370 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_rds_blue_green_deployment", name="Blue Green Deployment")
+func newBlueGreenDeploymentResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &blueGreenDeploymentResource{}
+
+	r.SetDefaultCreateTimeout(120 * time.Minute)
+	r.SetDefaultUpdateTimeout(120 * time.Minute)
+	r.SetDefaultDeleteTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	blueGreenDeploymentStatusSwitchoverCompleted = "SWITCHOVER_COMPLETED"
+)
+
+type blueGreenDeploymentResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*blueGreenDeploymentResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_rds_blue_green_deployment"
+}
+
+func (r *blueGreenDeploymentResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"blue_green_deployment_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"delete_source": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrSource: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+			},
+			"switchover": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"switchover_timeout": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(300),
+				Validators: []validator.Int64{
+					int64validator.Between(30, 3600),
+				},
+			},
+			names.AttrTarget: schema.StringAttribute{
+				Computed: true,
+			},
+			"target_db_cluster_parameter_group_name": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_db_instance_class": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_db_parameter_group_name": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_engine_version": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *blueGreenDeploymentResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data blueGreenDeploymentResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	name := data.BlueGreenDeploymentName.ValueString()
+	input := &rds.CreateBlueGreenDeploymentInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	orchestrator := newBlueGreenOrchestrator(conn)
+
+	dep, err := orchestrator.CreateDeployment(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating RDS Blue/Green Deployment (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, dep.BlueGreenDeploymentIdentifier)
+
+	timeout := r.CreateTimeout(ctx, data.Timeouts)
+	dep, err = orchestrator.waitForDeploymentAvailable(ctx, data.ID.ValueString(), timeout)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for RDS Blue/Green Deployment (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if data.Switchover.ValueBool() {
+		dep, err = r.switchover(ctx, orchestrator, &data, timeout)
+
+		if err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("creating RDS Blue/Green Deployment (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	data.Status = fwflex.StringToFramework(ctx, dep.Status)
+	data.Target = fwflex.StringToFramework(ctx, dep.Target)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *blueGreenDeploymentResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data blueGreenDeploymentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	output, err := findBlueGreenDeploymentByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading RDS Blue/Green Deployment (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.BlueGreenDeploymentName = fwflex.StringToFramework(ctx, output.BlueGreenDeploymentName)
+	// The Blue environment is renamed on switchover, so only set the source on import.
+	if data.Source.IsNull() {
+		data.Source = fwtypes.ARNValue(aws.ToString(output.Source))
+	}
+	data.Status = fwflex.StringToFramework(ctx, output.Status)
+	data.Target = fwflex.StringToFramework(ctx, output.Target)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *blueGreenDeploymentResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new blueGreenDeploymentResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	dep, err := findBlueGreenDeploymentByID(ctx, conn, new.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading RDS Blue/Green Deployment (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	timeout := r.UpdateTimeout(ctx, new.Timeouts)
+
+	switch status := aws.ToString(dep.Status); {
+	case new.Switchover.ValueBool() && status != blueGreenDeploymentStatusSwitchoverCompleted:
+		dep, err = r.switchover(ctx, newBlueGreenOrchestrator(conn), &new, timeout)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating RDS Blue/Green Deployment (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+	case new.DeleteSource.ValueBool() && !old.DeleteSource.ValueBool() && status == blueGreenDeploymentStatusSwitchoverCompleted:
+		if err := deleteBlueGreenDeploymentSource(ctx, conn, aws.ToString(dep.Source), timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating RDS Blue/Green Deployment (%s): deleting source", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	new.Status = fwflex.StringToFramework(ctx, dep.Status)
+	new.Target = fwflex.StringToFramework(ctx, dep.Target)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *blueGreenDeploymentResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data blueGreenDeploymentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	input := &rds.DeleteBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: data.ID.ValueStringPointer(),
+	}
+	// The Green environment is only deleted if the deployment hasn't been switched over.
+	if data.Status.ValueString() != blueGreenDeploymentStatusSwitchoverCompleted {
+		input.DeleteTarget = aws.Bool(true)
+	}
+
+	_, err := conn.DeleteBlueGreenDeployment(ctx, input)
+
+	if errs.IsA[*awstypes.BlueGreenDeploymentNotFoundFault](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting RDS Blue/Green Deployment (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitBlueGreenDeploymentDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for RDS Blue/Green Deployment (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *blueGreenDeploymentResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var old, new blueGreenDeploymentResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Status and target only change on switchover.
+	if new.Switchover.ValueBool() && old.Status.ValueString() != blueGreenDeploymentStatusSwitchoverCompleted {
+		return
+	}
+
+	new.Status = old.Status
+	new.Target = old.Target
+
+	response.Diagnostics.Append(response.Plan.Set(ctx, &new)...)
+}
+
+// switchover switches the deployment over to the Green environment and, if configured, deletes the Blue environment.
+func (r *blueGreenDeploymentResource) switchover(ctx context.Context, orchestrator *blueGreenOrchestrator, data *blueGreenDeploymentResourceModel, timeout time.Duration) (*awstypes.BlueGreenDeployment, error) {
+	deadline := tfresource.NewDeadline(timeout)
+
+	dep, err := orchestrator.Switchover(ctx, data.ID.ValueString(), deadline.Remaining(), func(input *rds.SwitchoverBlueGreenDeploymentInput) {
+		input.SwitchoverTimeout = fwflex.Int32FromFramework(ctx, data.SwitchoverTimeout)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if data.DeleteSource.ValueBool() {
+		if err := deleteBlueGreenDeploymentSource(ctx, orchestrator.conn, aws.ToString(dep.Source), deadline.Remaining()); err != nil {
+			return nil, fmt.Errorf("deleting source: %w", err)
+		}
+	}
+
+	return dep, nil
+}
+
+type blueGreenDeploymentResourceModel struct {
+	BlueGreenDeploymentName           types.String   `tfsdk:"blue_green_deployment_name"`
+	DeleteSource                      types.Bool     `tfsdk:"delete_source"`
+	ID                                types.String   `tfsdk:"id"`
+	Source                            fwtypes.ARN    `tfsdk:"source"`
+	Status                            types.String   `tfsdk:"status"`
+	Switchover                        types.Bool     `tfsdk:"switchover"`
+	SwitchoverTimeout                 types.Int64    `tfsdk:"switchover_timeout"`
+	Target                            types.String   `tfsdk:"target"`
+	TargetDBClusterParameterGroupName types.String   `tfsdk:"target_db_cluster_parameter_group_name"`
+	TargetDBInstanceClass             types.String   `tfsdk:"target_db_instance_class"`
+	TargetDBParameterGroupName        types.String   `tfsdk:"target_db_parameter_group_name"`
+	TargetEngineVersion               types.String   `tfsdk:"target_engine_version"`
+	Timeouts                          timeouts.Value `tfsdk:"timeouts"`
+}
@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSBlueGreenDeployment_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	var v awstypes.BlueGreenDeployment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_blue_green_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBlueGreenDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlueGreenDeploymentConfig_basic(rName, false, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBlueGreenDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_deployment_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "delete_source", acctest.CtFalse),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrSource, "aws_rds_cluster.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "AVAILABLE"),
+					resource.TestCheckResourceAttr(resourceName, "switchover", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "switchover_timeout", "300"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrTarget, "rds", regexache.MustCompile(`cluster:.+`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"delete_source",
+					"switchover",
+					"switchover_timeout",
+					"target_db_cluster_parameter_group_name",
+				},
+			},
+		},
+	})
+}
+
+func TestAccRDSBlueGreenDeployment_disappears(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	var v awstypes.BlueGreenDeployment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_blue_green_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBlueGreenDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlueGreenDeploymentConfig_basic(rName, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlueGreenDeploymentExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfrds.ResourceBlueGreenDeployment, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRDSBlueGreenDeployment_switchover(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	var v awstypes.BlueGreenDeployment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_blue_green_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBlueGreenDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlueGreenDeploymentConfig_basic(rName, false, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBlueGreenDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "AVAILABLE"),
+				),
+			},
+			{
+				Config: testAccBlueGreenDeploymentConfig_basic(rName, true, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBlueGreenDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "SWITCHOVER_COMPLETED"),
+					resource.TestCheckResourceAttr(resourceName, "switchover", acctest.CtTrue),
+					testAccCheckBlueGreenDeploymentSourceDeleted(ctx, &v),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlueGreenDeploymentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_rds_blue_green_deployment" {
+				continue
+			}
+
+			_, err := tfrds.FindBlueGreenDeploymentByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("RDS Blue/Green Deployment %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBlueGreenDeploymentExists(ctx context.Context, n string, v *awstypes.BlueGreenDeployment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+
+		output, err := tfrds.FindBlueGreenDeploymentByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckBlueGreenDeploymentSourceDeleted(ctx context.Context, v *awstypes.BlueGreenDeployment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+
+		sourceARN, err := tfrds.ParseDBClusterARN(aws.ToString(v.Source))
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfrds.FindDBClusterByID(ctx, conn, sourceARN.Identifier)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("RDS Blue/Green Deployment source %s still exists", sourceARN.Identifier)
+	}
+}
+
+func testAccBlueGreenDeploymentConfig_basic(rName string, switchover, deleteSource bool) string {
+	return acctest.ConfigCompose(testAccClusterConfig_BlueGreenDeployment_base(rName), fmt.Sprintf(`
+resource "aws_rds_cluster" "test" {
+  cluster_identifier              = %[1]q
+  database_name                   = "test"
+  db_cluster_parameter_group_name = aws_rds_cluster_parameter_group.test[0].name
+  engine                          = data.aws_rds_engine_version.test.engine
+  engine_version                  = data.aws_rds_engine_version.test.version
+  master_password                 = "avoid-plaintext-passwords"
+  master_username                 = "tfacctest"
+  skip_final_snapshot             = true
+  apply_immediately               = true
+
+  # The Green environment takes over the cluster identifier on switchover.
+  lifecycle {
+    ignore_changes = [db_cluster_parameter_group_name]
+  }
+}
+
+resource "aws_rds_cluster_instance" "test" {
+  identifier         = %[1]q
+  cluster_identifier = aws_rds_cluster.test.cluster_identifier
+  engine             = aws_rds_cluster.test.engine
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+}
+
+resource "aws_rds_blue_green_deployment" "test" {
+  blue_green_deployment_name             = %[1]q
+  source                                 = aws_rds_cluster.test.arn
+  target_db_cluster_parameter_group_name = aws_rds_cluster_parameter_group.test[1].name
+
+  switchover    = %[2]t
+  delete_source = %[3]t
+
+  depends_on = [aws_rds_cluster_instance.test]
+}
+`, rName, switchover, deleteSource))
+}
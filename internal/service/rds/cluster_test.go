@@ -1463,6 +1463,61 @@ func TestAccRDSCluster_engineVersion(t *testing.T) {
 	})
 }
 
+func TestAccRDSCluster_BlueGreenDeployment_updateParameterGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 types.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_BlueGreenDeployment_parameterGroup(rName, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttrPair(resourceName, "db_cluster_parameter_group_name", "aws_rds_cluster_parameter_group.test.0", names.AttrName),
+				),
+			},
+			{
+				Config: testAccClusterConfig_BlueGreenDeployment_parameterGroup(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v2),
+					testAccCheckClusterRecreated(&v1, &v2),
+					resource.TestCheckResourceAttrPair(resourceName, "db_cluster_parameter_group_name", "aws_rds_cluster_parameter_group.test.1", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrClusterIdentifier, rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSCluster_BlueGreenDeployment_invalidEngine(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterConfig_BlueGreenDeployment_invalidEngine(rName),
+				ExpectError: regexache.MustCompile(`"blue_green_update.enabled" cannot be set when "engine" is "postgres"`),
+			},
+		},
+	})
+}
+
 func TestAccRDSCluster_engineVersionWithPrimaryInstance(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -3895,6 +3950,86 @@ resource "aws_rds_cluster_instance" "test" {
 `, tfrds.ClusterEngineAuroraPostgreSQL, upgrade, rName, mainInstanceClasses)
 }
 
+func testAccClusterConfig_BlueGreenDeployment_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_version" "test" {
+  engine = %[1]q
+}
+
+# Blue/Green Deployments for Aurora MySQL require binary logging.
+resource "aws_rds_cluster_parameter_group" "test" {
+  count = 2
+
+  name   = "${%[2]q}-${count.index}"
+  family = data.aws_rds_engine_version.test.parameter_group_family
+
+  parameter {
+    apply_method = "pending-reboot"
+    name         = "binlog_format"
+    value        = "ROW"
+  }
+
+  parameter {
+    name  = "character_set_server"
+    value = count.index == 0 ? "utf8mb4" : "utf8"
+  }
+}
+
+data "aws_rds_orderable_db_instance" "test" {
+  engine                     = data.aws_rds_engine_version.test.engine
+  engine_version             = data.aws_rds_engine_version.test.version
+  preferred_instance_classes = [%[3]s]
+}
+`, tfrds.ClusterEngineAuroraMySQL, rName, mainInstanceClasses)
+}
+
+func testAccClusterConfig_BlueGreenDeployment_parameterGroup(rName string, index int) string {
+	return acctest.ConfigCompose(testAccClusterConfig_BlueGreenDeployment_base(rName), fmt.Sprintf(`
+resource "aws_rds_cluster" "test" {
+  cluster_identifier              = %[1]q
+  database_name                   = "test"
+  db_cluster_parameter_group_name = aws_rds_cluster_parameter_group.test[%[2]d].name
+  engine                          = data.aws_rds_engine_version.test.engine
+  engine_version                  = data.aws_rds_engine_version.test.version
+  master_password                 = "avoid-plaintext-passwords"
+  master_username                 = "tfacctest"
+  skip_final_snapshot             = true
+  apply_immediately               = true
+
+  blue_green_update {
+    enabled = true
+  }
+}
+
+resource "aws_rds_cluster_instance" "test" {
+  identifier         = %[1]q
+  cluster_identifier = aws_rds_cluster.test.cluster_identifier
+  engine             = aws_rds_cluster.test.engine
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+}
+`, rName, index))
+}
+
+func testAccClusterConfig_BlueGreenDeployment_invalidEngine(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_rds_cluster" "test" {
+  cluster_identifier        = %[1]q
+  engine                    = %[2]q
+  db_cluster_instance_class = "db.m5d.large"
+  storage_type              = "io1"
+  allocated_storage         = 100
+  iops                      = 1000
+  master_password           = "avoid-plaintext-passwords"
+  master_username           = "tfacctest"
+  skip_final_snapshot       = true
+
+  blue_green_update {
+    enabled = true
+  }
+}
+`, rName, tfrds.ClusterEnginePostgres)
+}
+
 func testAccClusterConfig_port(rName string, port int) string {
 	return fmt.Sprintf(`
 resource "aws_rds_cluster" "test" {
@@ -5,6 +5,7 @@ package rds
 
 // Exports for use in tests only.
 var (
+	ResourceBlueGreenDeployment                 = newBlueGreenDeploymentResource
 	ResourceCertificate                         = resourceCertificate
 	ResourceCluster                             = resourceCluster
 	ResourceClusterActivityStream               = resourceClusterActivityStream
@@ -13,6 +14,7 @@ var (
 	ResourceClusterParameterGroup               = resourceClusterParameterGroup
 	ResourceClusterRoleAssociation              = resourceClusterRoleAssociation
 	ResourceClusterSnapshot                     = resourceClusterSnapshot
+	ResourceClusterSnapshotCopy                 = resourceClusterSnapshotCopy
 	ResourceCustomDBEngineVersion               = resourceCustomDBEngineVersion
 	ResourceEventSubscription                   = resourceEventSubscription
 	ResourceGlobalCluster                       = resourceGlobalCluster
@@ -32,6 +34,7 @@ var (
 	ResourceSubnetGroup                         = resourceSubnetGroup
 
 	ClusterIDAndRegionFromARN                  = clusterIDAndRegionFromARN
+	FindBlueGreenDeploymentByID                = findBlueGreenDeploymentByID
 	FindCustomDBEngineVersionByTwoPartKey      = findCustomDBEngineVersionByTwoPartKey
 	FindDBClusterByID                          = findDBClusterByID
 	FindDBClusterEndpointByID                  = findDBClusterEndpointByID
@@ -58,6 +61,7 @@ var (
 	ListTags                                   = listTags
 	NewBlueGreenOrchestrator                   = newBlueGreenOrchestrator
 	ParameterGroupModifyChunk                  = parameterGroupModifyChunk
+	ParseDBClusterARN                          = parseDBClusterARN
 	ParseDBInstanceARN                         = parseDBInstanceARN
 	ProxyTargetParseResourceID                 = proxyTargetParseResourceID
 	WaitBlueGreenDeploymentDeleted             = waitBlueGreenDeploymentDeleted
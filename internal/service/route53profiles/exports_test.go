@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53profiles
+
+// Exports for use in tests only.
+var (
+	ResourceAssociation         = newAssociationResource
+	ResourceProfile             = newProfileResource
+	ResourceResourceAssociation = newResourceAssociationResource
+
+	FindAssociationByID         = findAssociationByID
+	FindProfileByID             = findProfileByID
+	FindResourceAssociationByID = findResourceAssociationByID
+)
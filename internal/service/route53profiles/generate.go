@@ -2,6 +2,7 @@
 // SPDX-License-Identifier: MPL-2.0
 
 //go:generate go run ../../generate/servicepackage/main.go
+//go:generate go run ../../generate/tags/main.go -ServiceTagsMap -KVTValues -SkipTypesImp -ListTags -UpdateTags
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package route53profiles
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build !generate
+// +build !generate
+
+package route53profiles
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53profiles/types"
+)
+
+// svcTagsSlice returns route53profiles service tags as a slice.
+// Tags are listed and updated as a map but passed to the Create APIs as a slice.
+func svcTagsSlice(tags map[string]string) []awstypes.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	apiObjects := make([]awstypes.Tag, 0, len(tags))
+
+	for k, v := range tags {
+		apiObjects = append(apiObjects, awstypes.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
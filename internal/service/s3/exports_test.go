@@ -49,6 +49,7 @@ var (
 	FindLoggingEnabled                    = findLoggingEnabled
 	FindMetricsConfiguration              = findMetricsConfiguration
 	FindObjectByBucketAndKey              = findObjectByBucketAndKey
+	FindObjectETagsByBucketAndPrefix      = findObjectETagsByBucketAndPrefix
 	FindObjectLockConfiguration           = findObjectLockConfiguration
 	FindOwnershipControls                 = findOwnershipControls
 	FindPublicAccessBlockConfiguration    = findPublicAccessBlockConfiguration
@@ -58,6 +59,8 @@ var (
 	IsDirectoryBucket                     = isDirectoryBucket
 	ObjectListTags                        = objectListTags
 	ObjectUpdateTags                      = objectUpdateTags
+	ObjectsSyncContentType                = objectsSyncContentType
+	ObjectsSyncKeyPrefix                  = objectsSyncKeyPrefix
 	SDKv1CompatibleCleanKey               = sdkv1CompatibleCleanKey
 	ValidBucketName                       = validBucketName
 
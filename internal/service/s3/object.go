@@ -588,9 +588,7 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.ChecksumAlgorithm = types.ChecksumAlgorithmCrc32
 	}
 
-	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...))
-
-	if _, err := uploader.Upload(ctx, input); err != nil {
+	if err := uploadObject(ctx, conn, input, optFns...); err != nil {
 		return sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): %s", aws.ToString(input.Key), aws.ToString(input.Bucket), err)
 	}
 
@@ -601,6 +599,14 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 	return append(diags, resourceObjectRead(ctx, d, meta)...)
 }
 
+func uploadObject(ctx context.Context, conn *s3.Client, input *s3.PutObjectInput, optFns ...func(*s3.Options)) error {
+	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...))
+
+	_, err := uploader.Upload(ctx, input)
+
+	return err
+}
+
 func setObjectKMSKeyID(ctx context.Context, meta interface{}, d *schema.ResourceData, sseKMSKeyID string) error {
 	// Only set non-default KMS key ID (one that doesn't match default).
 	if sseKMSKeyID != "" {
@@ -0,0 +1,586 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
+)
+
+// @SDKResource("aws_s3_objects_sync", name="Objects Sync")
+func resourceObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceObjectsSyncCreate,
+		ReadWithoutTimeout:   resourceObjectsSyncRead,
+		UpdateWithoutTimeout: resourceObjectsSyncUpdate,
+		DeleteWithoutTimeout: resourceObjectsSyncDelete,
+
+		CustomizeDiff: resourceObjectsSyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrBucket: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"content_type_override": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrContentType: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"pattern": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateObjectsSyncPattern,
+						},
+					},
+				},
+			},
+			"delete_extraneous": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"extraneous_keys": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			names.AttrKMSKeyID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"objects": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"server_side_encryption": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ServerSideEncryption](),
+			},
+			"source_dir": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceObjectsSyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket, prefix := d.Get(names.AttrBucket).(string), d.Get("key_prefix").(string)
+	id := bucket + "/" + prefix
+	prefix = objectsSyncKeyPrefix(prefix)
+
+	files, err := walkObjectsSyncSourceDir(d.Get("source_dir").(string), prefix)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Objects Sync (%s): %s", id, err)
+	}
+
+	keys := tfmaps.Keys(files)
+	slices.Sort(keys)
+	if err := uploadObjectsSyncFiles(ctx, d, meta, files, keys); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Objects Sync (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if d.Get("delete_extraneous").(bool) {
+		extraneous, err := findObjectsSyncExtraneousKeys(ctx, d, meta, files)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating S3 Objects Sync (%s): %s", d.Id(), err)
+		}
+
+		if err := deleteObjectsSyncKeys(ctx, d, meta, extraneous); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating S3 Objects Sync (%s): %s", d.Id(), err)
+		}
+	}
+
+	d.Set("objects", flattenObjectsSyncFiles(files))
+
+	return append(diags, resourceObjectsSyncRead(ctx, d, meta)...)
+}
+
+func resourceObjectsSyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn, optFns := objectsSyncClient(ctx, d, meta)
+
+	bucket, prefix := d.Get(names.AttrBucket).(string), objectsSyncKeyPrefix(d.Get("key_prefix").(string))
+	remote, err := findObjectETagsByBucketAndPrefix(ctx, conn, bucket, prefix, optFns...)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Objects Sync (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Objects Sync (%s): %s", d.Id(), err)
+	}
+
+	// Tracked objects that no longer exist are dropped so that they are planned for upload.
+	// Tracked objects whose content was changed outside Terraform are recorded with their remote hash so that they are planned for upload.
+	// Untracked objects under the prefix are recorded separately and only removed with delete_extraneous.
+	tracked := d.Get("objects").(map[string]interface{})
+	// The ETags of objects uploaded with KMS encryption never match.
+	_, kmsEncrypted := d.GetOk(names.AttrKMSKeyID)
+	switch types.ServerSideEncryption(d.Get("server_side_encryption").(string)) {
+	case types.ServerSideEncryptionAwsKms, types.ServerSideEncryptionAwsKmsDsse:
+		kmsEncrypted = true
+	}
+	objects := make(map[string]interface{})
+	for k, v := range tracked {
+		etag, ok := remote[k]
+		if !ok {
+			continue
+		}
+
+		if etag != v.(string) && !kmsEncrypted {
+			changed, err := objectsSyncObjectChanged(ctx, conn, bucket, k, etag, optFns...)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading S3 Objects Sync (%s) object (%s): %s", d.Id(), k, err)
+			}
+
+			if changed {
+				v = etag
+			}
+		}
+
+		objects[k] = v
+	}
+	var extraneous []string
+	for k := range remote {
+		if _, ok := tracked[k]; !ok {
+			extraneous = append(extraneous, k)
+		}
+	}
+
+	d.Set("extraneous_keys", extraneous)
+	d.Set("objects", objects)
+
+	return diags
+}
+
+func resourceObjectsSyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	files, err := walkObjectsSyncSourceDir(d.Get("source_dir").(string), objectsSyncKeyPrefix(d.Get("key_prefix").(string)))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Objects Sync (%s): %s", d.Id(), err)
+	}
+
+	o, _ := d.GetChange("objects")
+	old := o.(map[string]interface{})
+
+	// Settings that apply to every object force all of them to be uploaded again.
+	uploadAll := d.HasChanges("cache_control", "content_type_override", names.AttrKMSKeyID, "server_side_encryption")
+
+	var upload, remove []string
+	for k, f := range files {
+		if v, ok := old[k]; uploadAll || !ok || v.(string) != f.hash {
+			upload = append(upload, k)
+		}
+	}
+	for k := range old {
+		if _, ok := files[k]; !ok {
+			remove = append(remove, k)
+		}
+	}
+	slices.Sort(upload)
+	slices.Sort(remove)
+
+	if err := uploadObjectsSyncFiles(ctx, d, meta, files, upload); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Objects Sync (%s): %s", d.Id(), err)
+	}
+
+	// Objects tracked by the resource whose files were removed are always deleted, otherwise they would be orphaned.
+	// Untracked objects are never in the old objects and are only deleted below, per the new delete_extraneous.
+	if err := deleteObjectsSyncKeys(ctx, d, meta, remove); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Objects Sync (%s): %s", d.Id(), err)
+	}
+
+	if d.Get("delete_extraneous").(bool) {
+		extraneous, err := findObjectsSyncExtraneousKeys(ctx, d, meta, files)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating S3 Objects Sync (%s): %s", d.Id(), err)
+		}
+
+		if err := deleteObjectsSyncKeys(ctx, d, meta, extraneous); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating S3 Objects Sync (%s): %s", d.Id(), err)
+		}
+	}
+
+	d.Set("objects", flattenObjectsSyncFiles(files))
+
+	return append(diags, resourceObjectsSyncRead(ctx, d, meta)...)
+}
+
+func resourceObjectsSyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	keys := tfmaps.Keys(d.Get("objects").(map[string]interface{}))
+	slices.Sort(keys)
+
+	log.Printf("[DEBUG] Deleting S3 Objects Sync: %s", d.Id())
+	if err := deleteObjectsSyncKeys(ctx, d, meta, keys); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Objects Sync (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// resourceObjectsSyncCustomizeDiff hashes the source directory so that the plan shows
+// the keys that will be added, changed and removed.
+func resourceObjectsSyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// With delete_extraneous, untracked objects are planned for removal.
+	if d.Get("delete_extraneous").(bool) && d.Get("extraneous_keys").(*schema.Set).Len() > 0 {
+		if err := d.SetNew("extraneous_keys", []string{}); err != nil {
+			return err
+		}
+	}
+
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("key_prefix") {
+		return d.SetNewComputed("objects")
+	}
+
+	files, err := walkObjectsSyncSourceDir(d.Get("source_dir").(string), objectsSyncKeyPrefix(d.Get("key_prefix").(string)))
+	if err != nil {
+		return err
+	}
+
+	objects := flattenObjectsSyncFiles(files)
+
+	if o := d.Get("objects").(map[string]interface{}); len(o) == len(objects) {
+		equal := true
+		for k, v := range objects {
+			if o[k] != v {
+				equal = false
+				break
+			}
+		}
+
+		if equal {
+			return nil
+		}
+	}
+
+	return d.SetNew("objects", objects)
+}
+
+type objectsSyncFile struct {
+	hash string
+	path string
+}
+
+// walkObjectsSyncSourceDir returns the regular files under dir keyed by their object key.
+func walkObjectsSyncSourceDir(dir, prefix string) (map[string]objectsSyncFile, error) {
+	root, err := homedir.Expand(dir)
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source_dir (%s): %w", dir, err)
+	}
+
+	files := make(map[string]objectsSyncFile)
+
+	err = filepath.WalkDir(root, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+
+		hash, err := objectsSyncFileHash(p)
+		if err != nil {
+			return err
+		}
+
+		files[prefix+filepath.ToSlash(rel)] = objectsSyncFile{
+			hash: hash,
+			path: p,
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source_dir (%s): %w", dir, err)
+	}
+
+	return files, nil
+}
+
+func objectsSyncFileHash(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func uploadObjectsSyncFiles(ctx context.Context, d *schema.ResourceData, meta interface{}, files map[string]objectsSyncFile, keys []string) error {
+	conn, optFns := objectsSyncClient(ctx, d, meta)
+	bucket, prefix := d.Get(names.AttrBucket).(string), objectsSyncKeyPrefix(d.Get("key_prefix").(string))
+	overrides := d.Get("content_type_override").([]interface{})
+
+	for _, key := range keys {
+		if err := uploadObjectsSyncFile(ctx, conn, d, bucket, key, files[key].path, objectsSyncContentType(strings.TrimPrefix(key, prefix), overrides), optFns...); err != nil {
+			return fmt.Errorf("uploading S3 Object (%s) to Bucket (%s): %w", key, bucket, err)
+		}
+	}
+
+	return nil
+}
+
+func uploadObjectsSyncFile(ctx context.Context, conn *s3.Client, d *schema.ResourceData, bucket, key, path, contentType string, optFns ...func(*s3.Options)) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := file.Close()
+		if err != nil {
+			log.Printf("[WARN] Error closing S3 object source (%s): %s", path, err)
+		}
+	}()
+
+	input := &s3.PutObjectInput{
+		Body:   file,
+		Bucket: aws.String(bucket),
+		Key:    aws.String(sdkv1CompatibleCleanKey(key)),
+	}
+
+	if v, ok := d.GetOk("cache_control"); ok {
+		input.CacheControl = aws.String(v.(string))
+	}
+
+	if contentType != "" {
+		input.ContentType = aws.String(contentType)
+	}
+
+	if v, ok := d.GetOk(names.AttrKMSKeyID); ok {
+		input.SSEKMSKeyId = aws.String(v.(string))
+		input.ServerSideEncryption = types.ServerSideEncryptionAwsKms
+	}
+
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		input.ServerSideEncryption = types.ServerSideEncryption(v.(string))
+	}
+
+	return uploadObject(ctx, conn, input, optFns...)
+}
+
+func deleteObjectsSyncKeys(ctx context.Context, d *schema.ResourceData, meta interface{}, keys []string) error {
+	conn, optFns := objectsSyncClient(ctx, d, meta)
+	bucket := d.Get(names.AttrBucket).(string)
+
+	for _, key := range keys {
+		if err := deleteObjectVersion(ctx, conn, bucket, sdkv1CompatibleCleanKey(key), "", false, optFns...); err != nil {
+			return fmt.Errorf("deleting S3 Bucket (%s) Object (%s): %w", bucket, key, err)
+		}
+	}
+
+	return nil
+}
+
+func findObjectsSyncExtraneousKeys(ctx context.Context, d *schema.ResourceData, meta interface{}, files map[string]objectsSyncFile) ([]string, error) {
+	conn, optFns := objectsSyncClient(ctx, d, meta)
+
+	remote, err := findObjectETagsByBucketAndPrefix(ctx, conn, d.Get(names.AttrBucket).(string), objectsSyncKeyPrefix(d.Get("key_prefix").(string)), optFns...)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for k := range remote {
+		if _, ok := files[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+
+	return keys, nil
+}
+
+// objectsSyncKeyPrefix returns key_prefix with a trailing "/" so that it always names a "folder".
+// Otherwise a prefix of "app" would produce keys such as "appindex.html" and match objects under "app-other/".
+func objectsSyncKeyPrefix(prefix string) string {
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		return prefix + "/"
+	}
+
+	return prefix
+}
+
+// objectsSyncContentType returns the configured content type override for the file at rel,
+// falling back to the type registered for its extension.
+// Patterns without a "/" are matched against the file name, otherwise against the path relative to source_dir.
+func objectsSyncContentType(rel string, overrides []interface{}) string {
+	for _, tfMapRaw := range overrides {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		pattern := tfMap["pattern"].(string)
+		name := rel
+		if !strings.Contains(pattern, "/") {
+			name = path.Base(rel)
+		}
+
+		if matched, _ := path.Match(pattern, name); matched {
+			return tfMap[names.AttrContentType].(string)
+		}
+	}
+
+	return mime.TypeByExtension(path.Ext(rel))
+}
+
+func objectsSyncClient(ctx context.Context, d *schema.ResourceData, meta interface{}) (*s3.Client, []func(*s3.Options)) {
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+	var optFns []func(*s3.Options)
+
+	bucket := d.Get(names.AttrBucket).(string)
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+	// Via S3 access point: "Invalid configuration: region from ARN `us-east-1` does not match client region `aws-global` and UseArnRegion is `false`".
+	if arn.IsARN(bucket) && conn.Options().Region == names.GlobalRegionID {
+		optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
+	}
+
+	return conn, optFns
+}
+
+// findObjectETagsByBucketAndPrefix returns the ETag of every object under prefix keyed by object key.
+func findObjectETagsByBucketAndPrefix(ctx context.Context, conn *s3.Client, bucket, prefix string, optFns ...func(*s3.Options)) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+
+	output := make(map[string]string)
+
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx, optFns...)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Contents {
+			// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
+			output[aws.ToString(v.Key)] = strings.Trim(aws.ToString(v.ETag), `"`)
+		}
+	}
+
+	return output, nil
+}
+
+// objectsSyncObjectChanged reports whether an object whose ETag differs from the MD5 hash of its file has different content.
+// The ETag is only the MD5 hash of the content for objects uploaded in a single part and not encrypted with KMS.
+func objectsSyncObjectChanged(ctx context.Context, conn *s3.Client, bucket, key, etag string, optFns ...func(*s3.Options)) (bool, error) {
+	if strings.Contains(etag, "-") {
+		return false, nil
+	}
+
+	output, err := findObjectByBucketAndKey(ctx, conn, bucket, key, "", "", optFns...)
+
+	if tfresource.NotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	switch output.ServerSideEncryption {
+	case types.ServerSideEncryptionAwsKms, types.ServerSideEncryptionAwsKmsDsse:
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func flattenObjectsSyncFiles(files map[string]objectsSyncFile) map[string]interface{} {
+	tfMap := make(map[string]interface{}, len(files))
+
+	for k, v := range files {
+		tfMap[k] = v.hash
+	}
+
+	return tfMap
+}
+
+func validateObjectsSyncPattern(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := path.Match(v.(string), ""); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is not a valid pattern: %w", k, v, err))
+	}
+
+	return
+}
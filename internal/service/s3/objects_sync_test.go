@@ -0,0 +1,411 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestObjectsSyncContentType(t *testing.T) {
+	t.Parallel()
+
+	overrides := []interface{}{
+		map[string]interface{}{
+			"pattern":             "*.tpl",
+			names.AttrContentType: "text/html",
+		},
+		map[string]interface{}{
+			"pattern":             "assets/*",
+			names.AttrContentType: "application/octet-stream",
+		},
+		map[string]interface{}{
+			"pattern":             "*.html",
+			names.AttrContentType: "text/plain",
+		},
+	}
+
+	testCases := []struct {
+		rel      string
+		expected string
+	}{
+		{"index.html", "text/plain"},
+		{"nested/page.tpl", "text/html"},
+		{"assets/app.css", "application/octet-stream"},
+		{"assets/nested/app.css", "text/css; charset=utf-8"},
+		{"data.json", "application/json"},
+		{"README", ""},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.rel, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfs3.ObjectsSyncContentType(testCase.rel, overrides), testCase.expected; got != want {
+				t.Errorf("ObjectsSyncContentType(%q) = %q, want %q", testCase.rel, got, want)
+			}
+		})
+	}
+}
+
+func TestObjectsSyncKeyPrefix(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"":         "",
+		"site":     "site/",
+		"site/":    "site/",
+		"a/b/site": "a/b/site/",
+	}
+
+	for prefix, expected := range testCases {
+		t.Run(prefix, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfs3.ObjectsSyncKeyPrefix(prefix), expected; got != want {
+				t.Errorf("ObjectsSyncKeyPrefix(%q) = %q, want %q", prefix, got, want)
+			}
+		})
+	}
+}
+
+func TestAccS3ObjectsSync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_objects_sync.test"
+	dir := t.TempDir()
+
+	testAccObjectsSyncWriteFile(t, dir, "index.html", "<h1>index</h1>")
+	testAccObjectsSyncWriteFile(t, dir, "css/site.css", "body {}")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectsSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsSyncConfig_basic(rName, dir, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectsSyncRemoteKeys(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrBucket, rName),
+					resource.TestCheckResourceAttr(resourceName, "delete_extraneous", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", "site/"),
+					resource.TestCheckResourceAttr(resourceName, "objects.%", acctest.Ct2),
+					resource.TestCheckResourceAttrSet(resourceName, "objects.site/index.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "objects.site/css/site.css"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccObjectsSyncWriteFile(t, dir, "index.html", "<h1>updated</h1>")
+					testAccObjectsSyncWriteFile(t, dir, "app.js", "console.log(1)")
+				},
+				Config: testAccObjectsSyncConfig_basic(rName, dir, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectsSyncRemoteKeys(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "objects.%", acctest.Ct3),
+					resource.TestCheckResourceAttrSet(resourceName, "objects.site/app.js"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(dir, "app.js")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccObjectsSyncConfig_basic(rName, dir, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Objects uploaded by the resource are deleted with their files, even without delete_extraneous.
+					testAccCheckObjectsSyncRemoteKeys(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "objects.%", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectsSync_objectChanged(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_objects_sync.test"
+	dir := t.TempDir()
+
+	testAccObjectsSyncWriteFile(t, dir, "index.html", "<h1>index</h1>")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectsSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsSyncConfig_basic(rName, dir, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectsSyncObjectExists(ctx, "aws_s3_bucket.test", "site/index.html"),
+					testAccCheckBucketAddObjects(ctx, "aws_s3_bucket.test", "site/index.html"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccObjectsSyncConfig_basic(rName, dir, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectsSyncObjectETagMatches(ctx, resourceName, "site/index.html"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectsSync_deleteExtraneous(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_objects_sync.test"
+	dir := t.TempDir()
+
+	testAccObjectsSyncWriteFile(t, dir, "index.html", "<h1>index</h1>")
+	testAccObjectsSyncWriteFile(t, dir, "app.js", "console.log(1)")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectsSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsSyncConfig_deleteExtraneous(rName, dir, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectsSyncRemoteKeys(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "delete_extraneous", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", "site"),
+					resource.TestCheckResourceAttr(resourceName, "objects.%", acctest.Ct2),
+					resource.TestCheckResourceAttrSet(resourceName, "objects.site/index.html"),
+					testAccCheckBucketAddObjects(ctx, "aws_s3_bucket.test", "site/stray.txt", "site-other/keep.txt"),
+				),
+			},
+			{
+				// Untracked objects are recorded but left in place without delete_extraneous.
+				Config: testAccObjectsSyncConfig_deleteExtraneous(rName, dir, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectsSyncRemoteKeys(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "extraneous_keys.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "extraneous_keys.*", "site/stray.txt"),
+					resource.TestCheckResourceAttr(resourceName, "objects.%", acctest.Ct2),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(dir, "app.js")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccObjectsSyncConfig_deleteExtraneous(rName, dir, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectsSyncRemoteKeys(ctx, resourceName, 1),
+					testAccCheckObjectsSyncObjectExists(ctx, "aws_s3_bucket.test", "site-other/keep.txt"),
+					resource.TestCheckResourceAttr(resourceName, "delete_extraneous", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "extraneous_keys.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "objects.%", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "objects.site/index.html"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectsSync_contentTypeOverride(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_objects_sync.test"
+	dir := t.TempDir()
+
+	testAccObjectsSyncWriteFile(t, dir, "index.tpl", "<h1>index</h1>")
+	testAccObjectsSyncWriteFile(t, dir, "data.json", "{}")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectsSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsSyncConfig_contentTypeOverride(rName, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "content_type_override.#", acctest.Ct1),
+					resource.TestCheckResourceAttr("data.aws_s3_object.tpl", names.AttrContentType, "text/html"),
+					resource.TestCheckResourceAttr("data.aws_s3_object.json", names.AttrContentType, "application/json"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckObjectsSyncDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_objects_sync" {
+				continue
+			}
+
+			output, err := tfs3.FindObjectETagsByBucketAndPrefix(ctx, conn, rs.Primary.Attributes[names.AttrBucket], tfs3.ObjectsSyncKeyPrefix(rs.Primary.Attributes["key_prefix"]))
+
+			if err != nil {
+				// The bucket may already have been destroyed.
+				continue
+			}
+
+			for k := range output {
+				if _, ok := rs.Primary.Attributes["objects."+k]; ok {
+					return fmt.Errorf("S3 Objects Sync %s object %s still exists", rs.Primary.ID, k)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectsSyncRemoteKeys(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindObjectETagsByBucketAndPrefix(ctx, conn, rs.Primary.Attributes[names.AttrBucket], tfs3.ObjectsSyncKeyPrefix(rs.Primary.Attributes["key_prefix"]))
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("S3 Objects Sync (%s) has %d objects in the bucket, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectsSyncObjectExists(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.ID, key, "", "")
+
+		return err
+	}
+}
+
+func testAccCheckObjectsSyncObjectETagMatches(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], key, "", "")
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := strings.Trim(aws.ToString(output.ETag), `"`), rs.Primary.Attributes["objects."+key]; got != want {
+			return fmt.Errorf("S3 Objects Sync (%s) object (%s) has ETag %s, want %s", rs.Primary.ID, key, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccObjectsSyncWriteFile(t *testing.T, dir, name, data string) {
+	t.Helper()
+
+	path := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccObjectsSyncConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccObjectsSyncConfig_basic(rName, dir string, deleteExtraneous bool) string {
+	return acctest.ConfigCompose(testAccObjectsSyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_objects_sync" "test" {
+  bucket            = aws_s3_bucket.test.bucket
+  key_prefix        = "site/"
+  source_dir        = %[1]q
+  delete_extraneous = %[2]t
+}
+`, dir, deleteExtraneous))
+}
+
+func testAccObjectsSyncConfig_deleteExtraneous(rName, dir string, deleteExtraneous bool) string {
+	return acctest.ConfigCompose(testAccObjectsSyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_objects_sync" "test" {
+  bucket            = aws_s3_bucket.test.bucket
+  key_prefix        = "site"
+  source_dir        = %[1]q
+  delete_extraneous = %[2]t
+}
+`, dir, deleteExtraneous))
+}
+
+func testAccObjectsSyncConfig_contentTypeOverride(rName, dir string) string {
+	return acctest.ConfigCompose(testAccObjectsSyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_objects_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  source_dir = %[1]q
+
+  content_type_override {
+    pattern      = "*.tpl"
+    content_type = "text/html"
+  }
+}
+
+data "aws_s3_object" "tpl" {
+  bucket = aws_s3_objects_sync.test.bucket
+  key    = "index.tpl"
+}
+
+data "aws_s3_object" "json" {
+  bucket = aws_s3_objects_sync.test.bucket
+  key    = "data.json"
+}
+`, dir))
+}